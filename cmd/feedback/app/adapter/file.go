@@ -0,0 +1,55 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/joyfuldevs/project-lumos/cmd/feedback/app/service"
+)
+
+var _ service.Store = (*FileStore)(nil)
+
+// FileStore는 피드백을 JSON Lines 형식으로 파일에 추가 기록합니다.
+type FileStore struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewFileStore(path string) (*FileStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileStore{file: file}, nil
+}
+
+func (f *FileStore) Save(ctx context.Context, feedback service.Feedback) error {
+	data, err := json.Marshal(feedback)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, err := f.file.Write(data); err != nil {
+		return err
+	}
+	return f.file.Sync()
+}
+
+func (f *FileStore) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.file.Close()
+}
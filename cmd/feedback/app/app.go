@@ -0,0 +1,41 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/joyfuldevs/project-lumos/cmd/feedback/app/adapter"
+	"github.com/joyfuldevs/project-lumos/cmd/feedback/app/service"
+	"github.com/joyfuldevs/project-lumos/pkg/service/feedback/server"
+)
+
+func Run() error {
+	storePath, ok := os.LookupEnv("FEEDBACK_STORE_PATH")
+	if !ok {
+		return errors.New("FEEDBACK_STORE_PATH is not set")
+	}
+	store, err := adapter.NewFileStore(storePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			slog.Warn("failed to close feedback store", slog.Any("error", err))
+		}
+	}()
+
+	svc := service.NewService(store)
+
+	s := server.NewServer(
+		server.WithServiceV1(svc),
+	)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	return s.Serve(ctx)
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+type Feedback struct {
+	// 피드백 종류. e.g., "good", "bad"
+	Type string `json:"type"`
+	// 피드백 대상 스레드의 대화 내용.
+	Thread []string `json:"thread"`
+	// 피드백 제출 시각.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Store interface {
+	Save(ctx context.Context, feedback Feedback) error
+}
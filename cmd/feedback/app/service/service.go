@@ -0,0 +1,11 @@
+package service
+
+type Service struct {
+	Store Store
+}
+
+func NewService(s Store) *Service {
+	return &Service{
+		Store: s,
+	}
+}
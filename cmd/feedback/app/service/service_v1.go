@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/gen/go/feedback/v1"
+	"github.com/joyfuldevs/project-lumos/pkg/service/feedback/server"
+)
+
+var _ server.ServiceV1 = (*Service)(nil)
+
+func (s *Service) SubmitFeedback(ctx context.Context, feedbackType feedback.FeedbackType, thread []string) error {
+	var t string
+	switch feedbackType {
+	case feedback.FeedbackType_FEEDBACK_TYPE_GOOD:
+		t = "good"
+	case feedback.FeedbackType_FEEDBACK_TYPE_BAD:
+		t = "bad"
+	default:
+		t = "unspecified"
+	}
+
+	return s.Store.Save(ctx, Feedback{
+		Type:      t,
+		Thread:    thread,
+		CreatedAt: time.Now().UTC(),
+	})
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/joyfuldevs/project-lumos/cmd/feedback/app"
+)
+
+func main() {
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+	slog.Info("Feedback Service starting")
+	if err := app.Run(); err != nil {
+		slog.Error("failed to run feedback service", slog.Any("error", err))
+	}
+	slog.Info("Feedback Service finished")
+}
@@ -0,0 +1,106 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/cmd/issue-retrieval/app/service"
+	"github.com/joyfuldevs/project-lumos/pkg/jira"
+)
+
+var _ service.IssueRepository = (*FileRepository)(nil)
+
+// FileRepository는 jira-sync가 저장한 issues.json 파일에서 이슈를 찾습니다.
+//
+// 증분 동기화는 갱신된 이슈만 파일에 기록하므로, 파일을 다시 읽을 때는 기존 이슈를
+// 지우지 않고 같은 키의 이슈만 새 내용으로 교체합니다.
+type FileRepository struct {
+	path string
+
+	mu      sync.RWMutex
+	issues  map[string]jira.Issue
+	modTime time.Time
+}
+
+func NewFileRepository(path string) (*FileRepository, error) {
+	r := &FileRepository{
+		path:   path,
+		issues: make(map[string]jira.Issue),
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *FileRepository) FindIssues(ctx context.Context, keys []string) ([]jira.Issue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	issues := make([]jira.Issue, 0, len(keys))
+	for _, key := range keys {
+		if issue, ok := r.issues[key]; ok {
+			issues = append(issues, issue)
+		}
+	}
+	return issues, nil
+}
+
+// Watch는 ctx가 취소될 때까지 interval마다 파일이 바뀌었는지 확인하고 다시 읽습니다.
+func (r *FileRepository) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.load(); err != nil {
+				slog.Warn("failed to reload issues", slog.String("path", r.path), slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// load는 파일이 마지막으로 읽은 뒤에 바뀌었다면 이슈를 다시 읽어 병합합니다.
+func (r *FileRepository) load() error {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return err
+	}
+
+	r.mu.RLock()
+	unchanged := info.ModTime().Equal(r.modTime)
+	r.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return err
+	}
+	var issues []jira.Issue
+	if err := json.Unmarshal(data, &issues); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, issue := range issues {
+		r.issues[issue.Key] = issue
+	}
+	r.modTime = info.ModTime()
+
+	slog.Info("issues loaded",
+		slog.String("path", r.path),
+		slog.Int("count", len(issues)),
+		slog.Int("total", len(r.issues)))
+	return nil
+}
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/cmd/issue-retrieval/app/adapter"
+	"github.com/joyfuldevs/project-lumos/cmd/issue-retrieval/app/service"
+	"github.com/joyfuldevs/project-lumos/pkg/service/retrieval/issue/server"
+)
+
+func Run() error {
+	dataPath, ok := os.LookupEnv("ISSUE_DATA_PATH")
+	if !ok {
+		return errors.New("ISSUE_DATA_PATH is not set")
+	}
+	repository, err := adapter.NewFileRepository(dataPath)
+	if err != nil {
+		return err
+	}
+
+	svc := service.NewService(repository)
+
+	s := server.NewServer(
+		server.WithServiceV1(svc),
+	)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// jira-sync가 파일을 갱신하면 다시 읽어온다.
+	go repository.Watch(ctx, time.Minute)
+
+	return s.Serve(ctx)
+}
@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+
+	"github.com/joyfuldevs/project-lumos/pkg/jira"
+)
+
+type IssueRepository interface {
+	// FindIssues는 키에 해당하는 이슈를 찾습니다. 찾지 못한 키는 결과에서 제외됩니다.
+	FindIssues(ctx context.Context, keys []string) ([]jira.Issue, error)
+}
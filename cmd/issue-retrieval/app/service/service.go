@@ -0,0 +1,11 @@
+package service
+
+type Service struct {
+	IssueRepository IssueRepository
+}
+
+func NewService(r IssueRepository) *Service {
+	return &Service{
+		IssueRepository: r,
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/gen/go/retrieval/issue/v1"
+	"github.com/joyfuldevs/project-lumos/pkg/jira"
+	"github.com/joyfuldevs/project-lumos/pkg/service/retrieval/issue/server"
+)
+
+var _ server.ServiceV1 = (*Service)(nil)
+
+func (s *Service) Retrieve(ctx context.Context, keys []string) ([]*issue.Issue, error) {
+	found, err := s.IssueRepository.FindIssues(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]*issue.Issue, 0, len(found))
+	for _, i := range found {
+		comments := make([]string, 0, len(i.Fields.CommentInfo.Comments))
+		for _, c := range i.Fields.CommentInfo.Comments {
+			comments = append(comments, c.Author.Name+": "+c.Body)
+		}
+		issues = append(issues, &issue.Issue{
+			Key:      i.Key,
+			Title:    i.Fields.Title,
+			Content:  i.Fields.Content,
+			Comments: comments,
+			Status:   i.Fields.Status.Name,
+			Assignee: i.Fields.Assignee.Name,
+			Created:  rfc3339(i.Fields.Created),
+			Updated:  rfc3339(i.Fields.Updated),
+		})
+	}
+
+	return issues, nil
+}
+
+// rfc3339는 Jira 형식의 시각을 RFC 3339 형식으로 변환합니다. 변환할 수 없으면 빈 문자열을 반환합니다.
+func rfc3339(value string) string {
+	t, err := time.Parse(jira.TimeFormat, value)
+	if err != nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
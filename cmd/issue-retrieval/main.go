@@ -0,0 +1,18 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/joyfuldevs/project-lumos/cmd/issue-retrieval/app"
+)
+
+func main() {
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+	slog.Info("Issue Retrieval Service starting")
+	if err := app.Run(); err != nil {
+		slog.Error("failed to run issue retrieval service", slog.Any("error", err))
+	}
+	slog.Info("Issue Retrieval Service finished")
+}
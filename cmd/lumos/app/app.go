@@ -3,6 +3,8 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
@@ -11,6 +13,10 @@ import (
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/option"
 
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chain"
+	feedbackclient "github.com/joyfuldevs/project-lumos/pkg/service/feedback/client"
+	issueclient "github.com/joyfuldevs/project-lumos/pkg/service/retrieval/issue/client"
+	passageclient "github.com/joyfuldevs/project-lumos/pkg/service/retrieval/passage/client"
 	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
 	"github.com/joyfuldevs/project-lumos/pkg/slack/bot"
 )
@@ -19,12 +25,17 @@ func Run() error {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
-	slackClient, err := slackClientFromEnv()
+	config, err := configFromEnv()
+	if err != nil {
+		return err
+	}
+
+	generation, err := config.Generation.Generation()
 	if err != nil {
 		return err
 	}
 
-	resp, err := slackClient.OpenConnection(ctx)
+	slackClient, err := slackClientFromEnv()
 	if err != nil {
 		return err
 	}
@@ -34,10 +45,69 @@ func Run() error {
 		return err
 	}
 
-	botHandler := NewBotHandler(slackClient, openaiClient)
-	bot := bot.NewBot(botHandler)
+	feedbackClient, err := feedbackclient.NewClient(
+		feedbackclient.WithHost(config.Feedback.Host),
+		feedbackclient.WithPort(config.Feedback.Port),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create feedback client: %w", err)
+	}
+	defer func() {
+		if err := feedbackClient.Close(); err != nil {
+			slog.Warn("failed to close feedback client", slog.Any("error", err))
+		}
+	}()
+
+	retrievers := make([]chain.Retriever, 0, len(config.Retrieval))
+	for _, rc := range config.Retrieval {
+		client, err := passageclient.NewClient(
+			passageclient.WithHost(rc.Host),
+			passageclient.WithPort(rc.Port),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create %s retrieval client: %w", rc.Name, err)
+		}
+		defer func() {
+			if err := client.Close(); err != nil {
+				slog.Warn("failed to close retrieval client", slog.String("name", rc.Name), slog.Any("error", err))
+			}
+		}()
+		retrievers = append(retrievers, chain.Retriever{
+			Name:    rc.Name,
+			Client:  client,
+			Timeout: rc.Timeout,
+			Limit:   rc.Limit,
+		})
+	}
+
+	var issueClient chain.IssueClient
+	if !config.IssueRetrieval.Disabled {
+		client, err := issueclient.NewClient(
+			issueclient.WithHost(config.IssueRetrieval.Host),
+			issueclient.WithPort(config.IssueRetrieval.Port),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create issue retrieval client: %w", err)
+		}
+		defer func() {
+			if err := client.Close(); err != nil {
+				slog.Warn("failed to close issue retrieval client", slog.Any("error", err))
+			}
+		}()
+		issueClient = client
+	}
+
+	botHandler := NewBotHandler(config, generation, retrievers, issueClient, slackClient, openaiClient, feedbackClient)
+	opts := []bot.Option{
+		bot.WithWorkers(config.Bot.Workers),
+		bot.WithQueueSize(config.Bot.QueueSize),
+	}
+	if config.Bot.WarmStandby {
+		opts = append(opts, bot.WithWarmStandby(0))
+	}
+	bot := bot.NewBot(botHandler, opts...)
 
-	return bot.Run(ctx, resp.URL)
+	return bot.Serve(ctx, slackClient)
 }
 
 func slackClientFromEnv() (*api.Client, error) {
@@ -1,13 +1,33 @@
 package chain
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
 	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/mrkdwn"
 )
 
-func ChatResponse() chat.HandlerFunc {
+// 답변 메시지 끝에 덧붙이는 조건 미적용 안내, 잘림 안내, 구분선, 참고 이슈, 피드백 블록을 위해 남겨 두는 블록 수.
+const reservedBlocks = 5
+
+// response_url의 사용 횟수가 부족하여 답변의 일부만 보낼 때 덧붙이는 안내.
+const truncatedNotice = "답변이 길어 일부만 표시했어요. 질문을 나누어 다시 물어봐주세요."
+
+// 이슈 정보를 조회하지 못해 상세 검색의 상태, 담당자, 기간 조건을 적용하지 못했을 때 덧붙이는 안내.
+const unfilteredUserNotice = "이슈 정보를 조회하지 못해 상태, 담당자, 기간 조건을 적용하지 못했어요. 조건에 맞지 않는 이슈가 포함되었을 수 있어요."
+
+// ChatResponse는 생성된 답변을 Slack 메시지로 게시합니다.
+// 답변에 참고한 이슈가 있으면 jiraServer의 이슈 링크와 함께 표시합니다.
+//
+// 답변은 Markdown으로 보고 Slack 블록으로 변환하며, 한 메시지에 담을 수 없을 만큼 길면
+// 여러 메시지로 나누어 게시합니다. 참고 이슈와 피드백 버튼은 마지막 메시지에 표시합니다.
+//
+// 슬래시 커맨드로 시작된 대화는 response_url로 responseType에 맞게 답변합니다.
+func ChatResponse(jiraServer string, responseType interactive.ResponseType) chat.HandlerFunc {
 	return chat.HandlerFunc(func(chat *chat.Chat) {
 		ctx := chat.Context()
 		client := SlackClientFrom(ctx)
@@ -21,14 +41,118 @@ func ChatResponse() chat.HandlerFunc {
 			response = "답변을 생성하지 못했습니다.\n관리자에게 문의해주세요."
 		}
 
-		_, err := client.PostMessage(ctx, &api.PostMessageRequest{
-			Channel:         chat.Channel,
-			Text:            response,
-			ThreadTimestamp: chat.Timestamp,
-		})
-		if err != nil {
-			slog.Error("failed to post message", slog.Any("error", err))
+		messages := mrkdwn.Split(response, blockkit.MaxMessageBlocks-reservedBlocks)
+		if chat.ResponseURL != nil {
+			budget := chat.ResponseURL.Remaining()
+			if responseType == interactive.InChannel {
+				// 채널에 공개하는 답변은 접수 메시지를 지우는 데 한 번을 사용한다.
+				budget--
+			}
+			if budget <= 0 {
+				slog.Error("no response_url uses left to respond")
+				return
+			}
+			messages = limitMessages(messages, budget)
+		}
+		last := &messages[len(messages)-1]
+		if UnfilteredFrom(ctx) {
+			last.Text += "\n\n" + unfilteredUserNotice
+			last.Blocks = append(last.Blocks, noticeBlock(unfilteredUserNotice))
+		}
+		if citations := citationsOf(PassagesFrom(ctx)); len(citations) > 0 {
+			last.Text += "\n\n" + citationFallback(citations)
+			last.Blocks = append(last.Blocks,
+				blockkit.NewBlockWithDividerBlock(),
+				citationBlock(citations, jiraServer),
+			)
+		}
+
+		if chat.ResponseURL != nil {
+			respond(ctx, chat.ResponseURL, responseType, messages)
+			return
+		}
+		last.Blocks = append(last.Blocks, feedbackBlock(chat.Timestamp))
+
+		for i, m := range messages {
+			// 스트리밍으로 게시한 메시지가 있다면 첫 메시지는 해당 메시지를 갱신한다.
+			if ts := ResponseMessageFrom(ctx); i == 0 && ts != "" {
+				_, err := client.UpdateMessage(ctx, &api.UpdateMessageRequest{
+					Channel:   chat.Channel,
+					Timestamp: ts,
+					Text:      m.Text,
+					Blocks:    m.Blocks,
+				})
+				if err != nil {
+					slog.Error("failed to update message", slog.Any("error", err))
+				}
+				continue
+			}
+
+			_, err := client.PostMessage(ctx, &api.PostMessageRequest{
+				Channel:         chat.Channel,
+				Text:            m.Text,
+				Blocks:          m.Blocks,
+				ThreadTimestamp: chat.Timestamp,
+			})
+			if err != nil {
+				slog.Error("failed to post message", slog.Any("error", err))
+				return
+			}
+		}
+	})
+}
+
+// respond는 response_url로 답변을 보냅니다.
+//
+// 슬래시 커맨드를 받으면 사용자에게만 보이는 접수 메시지를 먼저 보내므로, 같은 사용자에게만
+// 보이는 답변은 접수 메시지를 대체하고 채널에 공개하는 답변은 접수 메시지를 지운 뒤 보낸다.
+// 답변이 여러 메시지로 나뉘었다면 나머지 메시지는 이어서 새 메시지로 보낸다.
+func respond(
+	ctx context.Context,
+	responseURL *api.ResponseURL,
+	responseType interactive.ResponseType,
+	messages []mrkdwn.Message,
+) {
+	if responseType == interactive.InChannel {
+		if err := responseURL.Delete(ctx); err != nil {
+			slog.Warn("failed to delete acknowledgement", slog.Any("error", err))
+		}
+	}
+
+	for i, m := range messages {
+		payload := &interactive.ResponsePayload{
+			ResponseType:    responseType,
+			Text:            m.Text,
+			Blocks:          m.Blocks,
+			ReplaceOriginal: i == 0 && responseType != interactive.InChannel,
 		}
+		if err := responseURL.Send(ctx, payload); err != nil {
+			slog.Error("failed to respond", slog.Any("error", err))
+			return
+		}
+	}
+}
+
+// limitMessages는 메시지가 n개를 넘으면 앞의 n개만 남기고 마지막 메시지에 답변이 잘렸다는 안내를 덧붙입니다.
+func limitMessages(messages []mrkdwn.Message, n int) []mrkdwn.Message {
+	if len(messages) <= n {
+		return messages
+	}
+	slog.Warn("answer exceeds response_url uses, truncating",
+		slog.Int("messages", len(messages)),
+		slog.Int("remaining", n))
+
+	messages = messages[:n]
+	last := &messages[n-1]
+	last.Text += "\n\n" + truncatedNotice
+	last.Blocks = append(last.Blocks, noticeBlock(truncatedNotice))
+	return messages
+}
+
+// noticeBlock은 답변 아래에 작게 표시할 안내 블록을 생성합니다.
+func noticeBlock(text string) *blockkit.Block {
+	return blockkit.NewBlockWithContextBlock(&blockkit.ContextBlock{
+		Elements: []*blockkit.TextObject{blockkit.NewPlainText(text, true)},
 	})
 }
 
@@ -0,0 +1,77 @@
+package chain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+)
+
+// 컨텍스트 블록은 최대 10개의 요소를 가질 수 있으며, 그중 하나는 제목으로 사용한다.
+const maxCitations = 9
+
+// Citation은 답변 생성에 참고한 이슈 정보입니다.
+type Citation struct {
+	Key   string
+	Title string
+	Score float32
+}
+
+// citationsOf는 패시지 목록에서 이슈 키가 있는 패시지를 순서대로 추출합니다.
+func citationsOf(passages []*Passage) []Citation {
+	citations := make([]Citation, 0, min(len(passages), maxCitations))
+	seen := make(map[string]struct{}, len(passages))
+	for _, p := range passages {
+		info := ParsePassage(p)
+		if info.Key == "" {
+			continue
+		}
+		if _, ok := seen[info.Key]; ok {
+			continue
+		}
+		seen[info.Key] = struct{}{}
+
+		citations = append(citations, Citation{Key: info.Key, Title: info.Title, Score: p.Score})
+		if len(citations) == maxCitations {
+			break
+		}
+	}
+	return citations
+}
+
+// citationBlock은 참고한 이슈를 Jira 링크 목록으로 표시하는 컨텍스트 블록을 생성합니다.
+// jiraServer가 비어있으면 링크 없이 이슈 키만 표시합니다.
+func citationBlock(citations []Citation, jiraServer string) *blockkit.Block {
+	elements := make([]*blockkit.TextObject, 0, len(citations)+1)
+	elements = append(elements, blockkit.NewMarkdownText("*참고한 이슈*", false))
+
+	for _, c := range citations {
+		text := c.Key
+		if jiraServer != "" {
+			text = fmt.Sprintf("<%s/browse/%s|%s>", strings.TrimRight(jiraServer, "/"), c.Key, c.Key)
+		}
+		if c.Title != "" {
+			text += " " + escapeMarkdown(c.Title)
+		}
+		text += fmt.Sprintf(" (%.3f)", c.Score)
+		elements = append(elements, blockkit.NewMarkdownText(text, false))
+	}
+
+	return blockkit.NewBlockWithContextBlock(&blockkit.ContextBlock{
+		Elements: elements,
+	})
+}
+
+// citationFallback은 블록을 표시할 수 없는 알림 등에서 사용할 참고 이슈 문자열을 생성합니다.
+func citationFallback(citations []Citation) string {
+	keys := make([]string, 0, len(citations))
+	for _, c := range citations {
+		keys = append(keys, c.Key)
+	}
+	return "참고한 이슈: " + strings.Join(keys, ", ")
+}
+
+// escapeMarkdown은 Slack mrkdwn에서 제어 문자로 사용되는 문자를 이스케이프합니다.
+func escapeMarkdown(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
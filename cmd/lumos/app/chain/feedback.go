@@ -0,0 +1,66 @@
+package chain
+
+import (
+	"github.com/joyfuldevs/project-lumos/pkg/slack"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+)
+
+const (
+	// 답변이 도움이 되었을 때 누르는 버튼의 액션 ID.
+	FeedbackGoodActionID = "feedback_good"
+	// 답변이 도움이 되지 않았을 때 누르는 버튼의 액션 ID.
+	FeedbackBadActionID = "feedback_bad"
+)
+
+// feedbackBlock은 답변에 대한 피드백 버튼을 담은 블록을 생성합니다.
+// 버튼 값에는 피드백 대상 스레드의 타임스탬프를 저장합니다.
+func feedbackBlock(threadTimestamp slack.Timestamp) *blockkit.Block {
+	return blockkit.NewBlockWithActionBlock(&blockkit.ActionBlock{
+		Elements: []*blockkit.BlockElement{
+			blockkit.NewBlockElementWithButtonElement(&blockkit.ButtonElement{
+				Text:     blockkit.NewPlainText("👍 도움이 됐어요", true),
+				ActionID: FeedbackGoodActionID,
+				Value:    string(threadTimestamp),
+			}),
+			blockkit.NewBlockElementWithButtonElement(&blockkit.ButtonElement{
+				Text:     blockkit.NewPlainText("👎 아쉬워요", true),
+				ActionID: FeedbackBadActionID,
+				Value:    string(threadTimestamp),
+			}),
+		},
+	})
+}
+
+// FeedbackReceivedBlocks는 메시지 블록에서 피드백 버튼을 감사 인사로 바꾼 블록 목록을 반환합니다.
+// 피드백 버튼이 없으면 false를 반환합니다.
+func FeedbackReceivedBlocks(blocks []*blockkit.Block) ([]*blockkit.Block, bool) {
+	replaced := make([]*blockkit.Block, len(blocks))
+	found := false
+	for i, b := range blocks {
+		if !isFeedbackBlock(b) {
+			replaced[i] = b
+			continue
+		}
+		replaced[i] = blockkit.NewBlockWithContextBlock(&blockkit.ContextBlock{
+			Elements: []*blockkit.TextObject{blockkit.NewPlainText("감사합니다", true)},
+		})
+		found = true
+	}
+	return replaced, found
+}
+
+func isFeedbackBlock(b *blockkit.Block) bool {
+	if b.OfActionBlock == nil {
+		return false
+	}
+	for _, e := range b.OfActionBlock.Elements {
+		if e.OfButtonElement == nil {
+			continue
+		}
+		switch e.OfButtonElement.ActionID {
+		case FeedbackGoodActionID, FeedbackBadActionID:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,191 @@
+package chain
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+)
+
+// SearchFilter는 상세 검색에서 사용자가 지정한 검색 조건입니다.
+type SearchFilter struct {
+	// 검색할 Jira 프로젝트 키 목록. 비어있으면 모든 프로젝트를 검색합니다.
+	Projects []string
+	// 이슈 상태. e.g., "In Progress"
+	Status string
+	// 이슈 담당자 이름.
+	Assignee string
+	// 기간의 시작일과 종료일. YYYY-MM-DD 형식입니다.
+	// 기간 안에 생성되었거나 수정된 이슈, 즉 종료일까지 생성되고 시작일 이후에 수정된 이슈를 찾습니다.
+	Since, Until string
+}
+
+// active는 지정한 조건이 있는지 여부를 반환합니다.
+func (f *SearchFilter) active() bool {
+	return f != nil && (len(f.Projects) > 0 || f.needsIssue())
+}
+
+// needsIssue는 패시지로는 확인할 수 없어 이슈 정보로 확인해야 하는 조건이 있는지 여부를 반환합니다.
+func (f *SearchFilter) needsIssue() bool {
+	return f != nil && (f.Status != "" || f.Assignee != "" || f.Since != "" || f.Until != "")
+}
+
+// allows는 이슈가 프로젝트를 포함한 모든 조건에 맞는지 확인합니다. 조건이 없으면 true를 반환합니다.
+func (f *SearchFilter) allows(i *Issue) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Projects) > 0 && !inProjects(i.Key, f.Projects) {
+		return false
+	}
+	return f.matches(i)
+}
+
+// matches는 이슈가 상태, 담당자, 기간 조건에 맞는지 확인합니다.
+func (f *SearchFilter) matches(i *Issue) bool {
+	if f.Status != "" && !strings.EqualFold(i.Status, f.Status) {
+		return false
+	}
+	if f.Assignee != "" && !strings.EqualFold(i.Assignee, f.Assignee) {
+		return false
+	}
+	if f.Since != "" {
+		if updated := issueDate(i.Updated); updated == "" || updated < f.Since {
+			return false
+		}
+	}
+	if f.Until != "" {
+		if created := issueDate(i.Created); created == "" || created > f.Until {
+			return false
+		}
+	}
+	return true
+}
+
+// issueDate는 RFC 3339 형식의 이슈 시각을 YYYY-MM-DD 형식의 날짜로 바꿉니다.
+// 날짜는 이슈 시각의 시간대를 기준으로 하며, 시각을 읽을 수 없으면 빈 문자열을 반환합니다.
+func issueDate(value string) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+// Constraints는 답변 생성에 알려줄 조건 목록을 반환합니다.
+func (f *SearchFilter) Constraints() []string {
+	if f == nil {
+		return nil
+	}
+
+	var constraints []string
+	if f.Status != "" {
+		constraints = append(constraints, "상태: "+f.Status)
+	}
+	if f.Assignee != "" {
+		constraints = append(constraints, "담당자: "+f.Assignee)
+	}
+	switch {
+	case f.Since != "" && f.Until != "":
+		constraints = append(constraints, "기간: "+f.Since+" ~ "+f.Until)
+	case f.Since != "":
+		constraints = append(constraints, "기간: "+f.Since+" 이후")
+	case f.Until != "":
+		constraints = append(constraints, "기간: "+f.Until+" 이전")
+	}
+	return constraints
+}
+
+type searchFilterKeyType int
+
+const searchFilterKey searchFilterKeyType = iota
+
+// WithSearchFilter는 상세 검색에서 지정한 검색 조건을 저장합니다.
+func WithSearchFilter(parent context.Context, filter *SearchFilter) context.Context {
+	return context.WithValue(parent, searchFilterKey, filter)
+}
+
+func SearchFilterFrom(ctx context.Context) *SearchFilter {
+	info, _ := ctx.Value(searchFilterKey).(*SearchFilter)
+	return info
+}
+
+// SearchFiltering은 검색 서비스별 결과에서 상세 검색의 프로젝트 조건에 맞지 않는 패시지를 제외합니다.
+//
+// 상태, 담당자, 기간처럼 패시지로 확인할 수 없는 조건은 IssueFiltering에서 이슈 정보로 확인합니다.
+func SearchFiltering(handler chat.Handler) chat.HandlerFunc {
+	return chat.HandlerFunc(func(chat *chat.Chat) {
+		ctx := chat.Context()
+
+		filter := SearchFilterFrom(ctx)
+		if filter == nil || len(filter.Projects) == 0 {
+			handler.HandleChat(chat)
+			return
+		}
+
+		results := RetrievalResultsFrom(ctx)
+		filtered := make([]RetrievalResult, 0, len(results))
+		for _, result := range results {
+			passages := make([]*Passage, 0, len(result.Passages))
+			for _, p := range result.Passages {
+				if inProjects(ParsePassage(p).Key, filter.Projects) {
+					passages = append(passages, p)
+				}
+			}
+			filtered = append(filtered, RetrievalResult{Source: result.Source, Passages: passages, Err: result.Err})
+		}
+
+		slog.Info("filtered passages by projects", slog.String("projects", strings.Join(filter.Projects, ",")))
+		handler.HandleChat(chat.WithContext(WithRetrievalResults(ctx, filtered...)))
+	})
+}
+
+// IssueFiltering은 상세 검색의 상태, 담당자, 기간 조건에 맞지 않는 이슈의 패시지를 제외합니다.
+//
+// IssueEnrichment가 조회한 이슈 정보로 조건을 확인하므로 IssueEnrichment 다음에 실행되어야 합니다.
+// 조회 결과에 없는 이슈의 패시지는 조건에 맞는지 알 수 없으므로 제외합니다.
+//
+// 이슈 조회 자체에 실패했다면 조건에 맞는 이슈가 없다고 답하지 않도록 패시지를 거르지 않습니다.
+// 이때 FailedSources에 IssueSource가 기록되어 있으므로 답변에 조건을 적용하지 못했다고 안내합니다.
+func IssueFiltering(handler chat.Handler) chat.HandlerFunc {
+	return chat.HandlerFunc(func(chat *chat.Chat) {
+		ctx := chat.Context()
+
+		filter := SearchFilterFrom(ctx)
+		if !filter.needsIssue() {
+			handler.HandleChat(chat)
+			return
+		}
+		if issueLookupFailed(ctx) {
+			slog.Warn("skipped filtering passages by issue fields, issue lookup failed")
+			handler.HandleChat(chat)
+			return
+		}
+
+		issues := make(map[string]*Issue)
+		for _, i := range IssuesFrom(ctx) {
+			issues[i.Key] = i
+		}
+
+		passages := PassagesFrom(ctx)
+		filtered := make([]*Passage, 0, len(passages))
+		for _, p := range passages {
+			if i, ok := issues[ParsePassage(p).Key]; ok && filter.matches(i) {
+				filtered = append(filtered, p)
+			}
+		}
+
+		slog.Info("filtered passages by issue fields",
+			slog.Int("passages", len(passages)),
+			slog.Int("matched", len(filtered)))
+		handler.HandleChat(chat.WithContext(WithPassages(ctx, filtered...)))
+	})
+}
+
+// UnfilteredFrom은 상세 검색에서 이슈 정보로 확인할 조건을 지정했지만 이슈 조회에 실패하여
+// 조건을 적용하지 못했는지 여부를 반환합니다.
+func UnfilteredFrom(ctx context.Context) bool {
+	return SearchFilterFrom(ctx).needsIssue() && issueLookupFailed(ctx)
+}
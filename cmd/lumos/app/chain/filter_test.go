@@ -0,0 +1,274 @@
+package chain_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chain"
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+)
+
+func TestSearchFiltering(t *testing.T) {
+	results := []chain.RetrievalResult{
+		{Source: "dense", Passages: []*chain.Passage{
+			issuePassage("PAY-1", "a", 0.9),
+			issuePassage("AUTH-1", "b", 0.8),
+			{Score: 0.7, Content: []byte("키가 없는 본문")},
+		}},
+		{Source: "sparse", Passages: []*chain.Passage{
+			issuePassage("USER-1", "c", 12),
+			issuePassage("PAY-2", "d", 10),
+		}},
+	}
+
+	testCases := []struct {
+		desc   string
+		filter *chain.SearchFilter
+		want   map[string][]string
+	}{
+		{
+			desc: "no filter",
+			want: map[string][]string{
+				"dense":  {"PAY-1:a", "AUTH-1:b", ":"},
+				"sparse": {"USER-1:c", "PAY-2:d"},
+			},
+		},
+		{
+			desc:   "no projects",
+			filter: &chain.SearchFilter{Status: "Done"},
+			want: map[string][]string{
+				"dense":  {"PAY-1:a", "AUTH-1:b", ":"},
+				"sparse": {"USER-1:c", "PAY-2:d"},
+			},
+		},
+		{
+			desc:   "one project",
+			filter: &chain.SearchFilter{Projects: []string{"PAY"}},
+			want: map[string][]string{
+				"dense":  {"PAY-1:a"},
+				"sparse": {"PAY-2:d"},
+			},
+		},
+		{
+			desc:   "several projects",
+			filter: &chain.SearchFilter{Projects: []string{"AUTH", "USER"}},
+			want: map[string][]string{
+				"dense":  {"AUTH-1:b"},
+				"sparse": {"USER-1:c"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var got []chain.RetrievalResult
+			handler := chain.SearchFiltering(chat.HandlerFunc(func(c *chat.Chat) {
+				got = chain.RetrievalResultsFrom(c.Context())
+			}))
+
+			ctx := chain.WithRetrievalResults(context.Background(), results...)
+			ctx = chain.WithSearchFilter(ctx, tc.filter)
+			handler.HandleChat((&chat.Chat{}).WithContext(ctx))
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %d results, want %d", len(got), len(tc.want))
+			}
+			for _, result := range got {
+				if keys := keysOf(result.Passages); !slices.Equal(keys, tc.want[result.Source]) {
+					t.Errorf("%s: got %q, want %q", result.Source, keys, tc.want[result.Source])
+				}
+			}
+		})
+	}
+}
+
+func TestIssueFiltering(t *testing.T) {
+	issues := []*chain.Issue{
+		{
+			Key:      "PAY-1",
+			Status:   "In Progress",
+			Assignee: "홍길동",
+			Created:  "2025-01-10T09:00:00+09:00",
+			Updated:  "2025-03-01T18:00:00+09:00",
+		},
+		{
+			Key:      "PAY-2",
+			Status:   "Done",
+			Assignee: "Jane Doe",
+			Created:  "2025-04-01T00:30:00+09:00",
+			Updated:  "2025-05-20T10:00:00+09:00",
+		},
+		{
+			Key:     "PAY-3",
+			Status:  "To Do",
+			Created: "2024-12-01T10:00:00+09:00",
+			Updated: "2024-12-31T23:30:00+09:00",
+		},
+		{
+			Key:     "PAY-4",
+			Status:  "Done",
+			Created: "invalid",
+			Updated: "invalid",
+		},
+	}
+	passages := []*chain.Passage{
+		issuePassage("PAY-1", "a", 0.9),
+		issuePassage("PAY-2", "b", 0.8),
+		issuePassage("PAY-3", "c", 0.7),
+		issuePassage("PAY-4", "d", 0.6),
+		// 이슈를 조회하지 못한 패시지.
+		issuePassage("PAY-5", "e", 0.5),
+	}
+
+	testCases := []struct {
+		desc   string
+		filter *chain.SearchFilter
+		want   []string
+	}{
+		{
+			desc: "no filter",
+			want: []string{"PAY-1:a", "PAY-2:b", "PAY-3:c", "PAY-4:d", "PAY-5:e"},
+		},
+		{
+			desc:   "only projects",
+			filter: &chain.SearchFilter{Projects: []string{"PAY"}},
+			want:   []string{"PAY-1:a", "PAY-2:b", "PAY-3:c", "PAY-4:d", "PAY-5:e"},
+		},
+		{
+			desc:   "status ignores case",
+			filter: &chain.SearchFilter{Status: "done"},
+			want:   []string{"PAY-2:b", "PAY-4:d"},
+		},
+		{
+			desc:   "assignee",
+			filter: &chain.SearchFilter{Assignee: "jane doe"},
+			want:   []string{"PAY-2:b"},
+		},
+		{
+			desc:   "since excludes issues last updated before",
+			filter: &chain.SearchFilter{Since: "2025-01-01"},
+			want:   []string{"PAY-1:a", "PAY-2:b"},
+		},
+		{
+			desc:   "until excludes issues created after",
+			filter: &chain.SearchFilter{Until: "2025-03-31"},
+			want:   []string{"PAY-1:a", "PAY-3:c"},
+		},
+		{
+			desc:   "dates use the time zone of the issue",
+			filter: &chain.SearchFilter{Since: "2024-12-31", Until: "2025-04-01"},
+			want:   []string{"PAY-1:a", "PAY-2:b", "PAY-3:c"},
+		},
+		{
+			desc:   "all conditions",
+			filter: &chain.SearchFilter{Status: "In Progress", Assignee: "홍길동", Since: "2025-02-01", Until: "2025-02-28"},
+			want:   []string{"PAY-1:a"},
+		},
+		{
+			desc:   "no match",
+			filter: &chain.SearchFilter{Status: "Closed"},
+			want:   []string{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var got []*chain.Passage
+			handler := chain.IssueFiltering(chat.HandlerFunc(func(c *chat.Chat) {
+				got = chain.PassagesFrom(c.Context())
+			}))
+
+			ctx := chain.WithPassages(context.Background(), passages...)
+			ctx = chain.WithIssues(ctx, issues...)
+			ctx = chain.WithSearchFilter(ctx, tc.filter)
+			handler.HandleChat((&chat.Chat{}).WithContext(ctx))
+
+			if keys := keysOf(got); !slices.Equal(keys, tc.want) {
+				t.Errorf("got %q, want %q", keys, tc.want)
+			}
+		})
+	}
+}
+
+// issueClient는 미리 정한 이슈나 오류를 반환하는 이슈 검색 서비스 클라이언트입니다.
+type issueClient struct {
+	issues []*chain.Issue
+	err    error
+	// block이 true면 요청의 제한 시간이 지날 때까지 응답하지 않습니다.
+	block bool
+}
+
+func (c *issueClient) RetrievalIssuesV1(ctx context.Context, keys []string) ([]*chain.Issue, error) {
+	if c.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return c.issues, c.err
+}
+
+func TestIssueFilteringLookupFailure(t *testing.T) {
+	passages := []*chain.Passage{
+		issuePassage("PAY-1", "a", 0.9),
+		issuePassage("PAY-2", "b", 0.8),
+	}
+
+	testCases := []struct {
+		desc           string
+		client         chain.IssueClient
+		wantPassages   []string
+		wantFailed     []string
+		wantUnfiltered bool
+	}{
+		{
+			desc:         "lookup succeeds",
+			client:       &issueClient{issues: []*chain.Issue{{Key: "PAY-2", Title: "b", Status: "Done"}}},
+			wantPassages: []string{"PAY-2:b"},
+		},
+		{
+			desc:           "lookup fails",
+			client:         &issueClient{err: errors.New("unavailable")},
+			wantPassages:   []string{"PAY-1:a", "PAY-2:b"},
+			wantFailed:     []string{chain.IssueSource},
+			wantUnfiltered: true,
+		},
+		{
+			desc:           "lookup times out",
+			client:         &issueClient{block: true},
+			wantPassages:   []string{"PAY-1:a", "PAY-2:b"},
+			wantFailed:     []string{chain.IssueSource},
+			wantUnfiltered: true,
+		},
+		{
+			desc:           "issue retrieval disabled",
+			wantPassages:   []string{"PAY-1:a", "PAY-2:b"},
+			wantFailed:     []string{chain.IssueSource},
+			wantUnfiltered: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var got *chat.Chat
+			handler := chain.IssueFiltering(chat.HandlerFunc(func(c *chat.Chat) {
+				got = c
+			}))
+			handler = chain.IssueEnrichment(handler, tc.client, 1, 10*time.Millisecond)
+
+			ctx := chain.WithRetrievalResults(context.Background(), chain.RetrievalResult{Source: "dense", Passages: passages})
+			ctx = chain.WithPassages(ctx, passages...)
+			ctx = chain.WithSearchFilter(ctx, &chain.SearchFilter{Status: "Done"})
+			handler.HandleChat((&chat.Chat{}).WithContext(ctx))
+
+			ctx = got.Context()
+			if keys := keysOf(chain.PassagesFrom(ctx)); !slices.Equal(keys, tc.wantPassages) {
+				t.Errorf("got passages %q, want %q", keys, tc.wantPassages)
+			}
+			if failed := chain.FailedSources(chain.RetrievalResultsFrom(ctx)); !slices.Equal(failed, tc.wantFailed) {
+				t.Errorf("got failed sources %q, want %q", failed, tc.wantFailed)
+			}
+			if unfiltered := chain.UnfilteredFrom(ctx); unfiltered != tc.wantUnfiltered {
+				t.Errorf("got unfiltered %v, want %v", unfiltered, tc.wantUnfiltered)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package chain
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+)
+
+// Fusion은 여러 검색 서비스의 결과를 하나의 점수로 결합하는 전략입니다.
+type Fusion interface {
+	// Fuse는 각 검색 결과를 결합하여 점수 내림차순으로 정렬된 패시지 목록을 반환합니다.
+	Fuse(results []RetrievalResult) []*Passage
+}
+
+// ReciprocalRankFusion은 각 검색 결과의 순위만을 사용하여 점수를 결합합니다.
+// 점수 체계가 다른 검색 서비스를 결합할 때 점수 정규화가 필요하지 않습니다.
+//
+//	score(d) = Σ weight(s) / (K + rank_s(d))
+type ReciprocalRankFusion struct {
+	// 순위 상수. 0 이하인 경우 60을 사용합니다.
+	K float64
+	// 검색 서비스별 가중치. 지정되지 않은 서비스는 1을 사용합니다.
+	Weights map[string]float64
+}
+
+func (f *ReciprocalRankFusion) Fuse(results []RetrievalResult) []*Passage {
+	k := f.K
+	if k <= 0 {
+		k = 60
+	}
+
+	return fuse(results, func(source string, rank int, _ float64) float64 {
+		return weightOf(f.Weights, source) / (k + float64(rank+1))
+	})
+}
+
+// WeightedScoreFusion은 검색 서비스별로 점수를 [0, 1] 범위로 정규화한 뒤 가중합으로 결합합니다.
+type WeightedScoreFusion struct {
+	// 검색 서비스별 가중치. 지정되지 않은 서비스는 1을 사용합니다.
+	Weights map[string]float64
+}
+
+func (f *WeightedScoreFusion) Fuse(results []RetrievalResult) []*Passage {
+	// 서비스별 최고 점수와 최저 점수를 구해 min-max 정규화에 사용한다.
+	bounds := make(map[string][2]float64, len(results))
+	for _, result := range results {
+		if len(result.Passages) == 0 {
+			continue
+		}
+		lo, hi := float64(result.Passages[0].Score), float64(result.Passages[0].Score)
+		for _, p := range result.Passages {
+			lo = min(lo, float64(p.Score))
+			hi = max(hi, float64(p.Score))
+		}
+		bounds[result.Source] = [2]float64{lo, hi}
+	}
+
+	return fuse(results, func(source string, _ int, score float64) float64 {
+		b := bounds[source]
+		normalized := 1.0
+		if b[1] > b[0] {
+			normalized = (score - b[0]) / (b[1] - b[0])
+		}
+		return weightOf(f.Weights, source) * normalized
+	})
+}
+
+func weightOf(weights map[string]float64, source string) float64 {
+	if w, ok := weights[source]; ok {
+		return w
+	}
+	return 1
+}
+
+// fuse는 이슈 키를 기준으로 중복된 패시지를 합치고 scoreFn으로 계산한 점수를 누적합니다.
+// 결합된 패시지의 내용은 가장 높은 기여도를 가진 패시지의 내용을 사용합니다.
+func fuse(results []RetrievalResult, scoreFn func(source string, rank int, score float64) float64) []*Passage {
+	type entry struct {
+		passage *Passage
+		score   float64
+		best    float64
+	}
+
+	entries := make(map[string]*entry)
+	order := make([]string, 0)
+	for _, result := range results {
+		for rank, p := range result.Passages {
+			key := passageIdentity(p)
+			s := scoreFn(result.Source, rank, float64(p.Score))
+
+			e, ok := entries[key]
+			if !ok {
+				entries[key] = &entry{passage: p, score: s, best: s}
+				order = append(order, key)
+				continue
+			}
+			e.score += s
+			if s > e.best {
+				e.passage = p
+				e.best = s
+			}
+		}
+	}
+
+	passages := make([]*Passage, 0, len(order))
+	for _, key := range order {
+		e := entries[key]
+		passages = append(passages, &Passage{
+			Score:   float32(e.score),
+			Content: e.passage.Content,
+		})
+	}
+
+	// 동점인 경우 먼저 등장한 패시지를 우선한다.
+	slices.SortStableFunc(passages, func(a, b *Passage) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	return passages
+}
+
+// passageIdentity는 패시지의 중복 판단 기준을 반환합니다.
+// 이슈 키를 찾을 수 없는 경우 내용 자체를 사용합니다.
+func passageIdentity(p *Passage) string {
+	if info := ParsePassage(p); info.Key != "" {
+		return info.Key
+	}
+	return string(p.Content)
+}
+
+// PassageFusion은 검색 서비스별 결과를 fusion 전략으로 결합하고 상위 topN 개의 패시지만 유지합니다.
+// 상세 검색에서 이슈 정보로 확인할 조건을 지정했다면 IssueFiltering에서 제외될 패시지를 고려하여 더 많이 유지합니다.
+func PassageFusion(handler chat.Handler, fusion Fusion, topN int) chat.HandlerFunc {
+	return chat.HandlerFunc(func(chat *chat.Chat) {
+		ctx := chat.Context()
+
+		results := RetrievalResultsFrom(ctx)
+		if len(results) == 0 {
+			handler.HandleChat(chat)
+			return
+		}
+
+		passages := fusion.Fuse(results)
+		limit := topN
+		if SearchFilterFrom(ctx).needsIssue() {
+			limit *= filteredLimitFactor
+		}
+		if limit > 0 && len(passages) > limit {
+			passages = passages[:limit]
+		}
+
+		chat = chat.WithContext(WithPassages(ctx, passages...))
+		handler.HandleChat(chat)
+	})
+}
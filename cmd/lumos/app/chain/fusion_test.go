@@ -0,0 +1,209 @@
+package chain_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chain"
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+)
+
+// issuePassage는 검색 서비스가 보내는 형식으로 이슈 key의 패시지를 생성합니다.
+func issuePassage(key, title string, score float32) *chain.Passage {
+	content, _ := json.Marshal(map[string]any{
+		"Score":   score,
+		"Key":     key,
+		"Title":   title,
+		"Content": title + " 본문",
+	})
+	return &chain.Passage{Score: score, Content: content}
+}
+
+// keysOf는 패시지 목록을 비교하기 쉽도록 "키:제목" 형식으로 요약합니다.
+func keysOf(passages []*chain.Passage) []string {
+	s := make([]string, 0, len(passages))
+	for _, p := range passages {
+		info := chain.ParsePassage(p)
+		s = append(s, info.Key+":"+info.Title)
+	}
+	return s
+}
+
+func TestReciprocalRankFusion(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		weights map[string]float64
+		results []chain.RetrievalResult
+		want    []string
+	}{
+		{
+			desc: "duplicated issue ranks first",
+			results: []chain.RetrievalResult{
+				{Source: "dense", Passages: []*chain.Passage{
+					issuePassage("PAY-1", "a", 0.9),
+					issuePassage("PAY-2", "b", 0.8),
+				}},
+				{Source: "sparse", Passages: []*chain.Passage{
+					issuePassage("PAY-2", "b", 12),
+					issuePassage("PAY-3", "c", 10),
+				}},
+			},
+			want: []string{"PAY-2:b", "PAY-1:a", "PAY-3:c"},
+		},
+		{
+			desc:    "weighted source ranks first",
+			weights: map[string]float64{"dense": 0.5},
+			results: []chain.RetrievalResult{
+				{Source: "dense", Passages: []*chain.Passage{issuePassage("PAY-1", "a", 0.9)}},
+				{Source: "sparse", Passages: []*chain.Passage{issuePassage("PAY-2", "b", 12)}},
+			},
+			want: []string{"PAY-2:b", "PAY-1:a"},
+		},
+		{
+			desc: "ties keep the first seen order",
+			results: []chain.RetrievalResult{
+				{Source: "dense", Passages: []*chain.Passage{issuePassage("PAY-1", "a", 0.9)}},
+				{Source: "sparse", Passages: []*chain.Passage{issuePassage("PAY-2", "b", 12)}},
+			},
+			want: []string{"PAY-1:a", "PAY-2:b"},
+		},
+		{
+			desc:    "duplicated issue keeps the content of the larger contribution",
+			weights: map[string]float64{"dense": 0.5},
+			results: []chain.RetrievalResult{
+				{Source: "dense", Passages: []*chain.Passage{issuePassage("PAY-1", "dense", 0.9)}},
+				{Source: "sparse", Passages: []*chain.Passage{issuePassage("PAY-1", "sparse", 12)}},
+			},
+			want: []string{"PAY-1:sparse"},
+		},
+		{
+			desc: "passages without key are merged by content",
+			results: []chain.RetrievalResult{
+				{Source: "dense", Passages: []*chain.Passage{
+					{Score: 0.9, Content: []byte("본문")},
+					issuePassage("PAY-1", "a", 0.8),
+				}},
+				{Source: "sparse", Passages: []*chain.Passage{{Score: 12, Content: []byte("본문")}}},
+			},
+			want: []string{":", "PAY-1:a"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			fusion := &chain.ReciprocalRankFusion{Weights: tc.weights}
+			got := keysOf(fusion.Fuse(tc.results))
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWeightedScoreFusion(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		weights map[string]float64
+		results []chain.RetrievalResult
+		want    []string
+		scores  []float32
+	}{
+		{
+			desc: "scores are normalized per source",
+			results: []chain.RetrievalResult{
+				{Source: "dense", Passages: []*chain.Passage{
+					issuePassage("PAY-1", "a", 0.9),
+					issuePassage("PAY-2", "b", 0.5),
+					issuePassage("PAY-3", "c", 0.1),
+				}},
+				{Source: "sparse", Passages: []*chain.Passage{
+					issuePassage("PAY-3", "c", 20),
+					issuePassage("PAY-4", "d", 10),
+				}},
+			},
+			want:   []string{"PAY-1:a", "PAY-3:c", "PAY-2:b", "PAY-4:d"},
+			scores: []float32{1, 1, 0.5, 0},
+		},
+		{
+			desc:    "weights scale normalized scores",
+			weights: map[string]float64{"dense": 0.5},
+			results: []chain.RetrievalResult{
+				{Source: "dense", Passages: []*chain.Passage{
+					issuePassage("PAY-1", "a", 0.9),
+					issuePassage("PAY-2", "b", 0.1),
+				}},
+				{Source: "sparse", Passages: []*chain.Passage{
+					issuePassage("PAY-3", "c", 5),
+					issuePassage("PAY-4", "d", 1),
+				}},
+			},
+			want:   []string{"PAY-3:c", "PAY-1:a", "PAY-2:b", "PAY-4:d"},
+			scores: []float32{1, 0.5, 0, 0},
+		},
+		{
+			desc: "single passage gets full score",
+			results: []chain.RetrievalResult{
+				{Source: "dense", Passages: []*chain.Passage{issuePassage("PAY-1", "a", 0.3)}},
+				{Source: "sparse", Passages: []*chain.Passage{issuePassage("PAY-1", "a", 7)}},
+			},
+			want:   []string{"PAY-1:a"},
+			scores: []float32{2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			fusion := &chain.WeightedScoreFusion{Weights: tc.weights}
+			passages := fusion.Fuse(tc.results)
+			if got := keysOf(passages); !slices.Equal(got, tc.want) {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+			scores := make([]float32, 0, len(passages))
+			for _, p := range passages {
+				scores = append(scores, p.Score)
+			}
+			if !slices.Equal(scores, tc.scores) {
+				t.Errorf("got scores %v, want %v", scores, tc.scores)
+			}
+		})
+	}
+}
+
+func TestPassageFusion(t *testing.T) {
+	var passages []*chain.Passage
+	for i := range 5 {
+		passages = append(passages, issuePassage(fmt.Sprintf("PAY-%d", i+1), "", 1))
+	}
+
+	testCases := []struct {
+		desc string
+		topN int
+		want int
+	}{
+		{desc: "keeps top n", topN: 3, want: 3},
+		{desc: "keeps all when fewer than top n", topN: 10, want: 5},
+		{desc: "keeps all when top n is zero", topN: 0, want: 5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var got []*chain.Passage
+			handler := chain.PassageFusion(chat.HandlerFunc(func(c *chat.Chat) {
+				got = chain.PassagesFrom(c.Context())
+			}), &chain.ReciprocalRankFusion{}, tc.topN)
+
+			ctx := chain.WithRetrievalResults(context.Background(), chain.RetrievalResult{
+				Source:   "dense",
+				Passages: passages,
+			})
+			handler.HandleChat((&chat.Chat{}).WithContext(ctx))
+
+			if len(got) != tc.want {
+				t.Fatalf("got %d passages, want %d", len(got), tc.want)
+			}
+			if key := chain.ParsePassage(got[0]).Key; key != "PAY-1" {
+				t.Errorf("got first passage %s, want PAY-1", key)
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package chain
 
 import (
 	"context"
-	"log/slog"
 
 	"github.com/openai/openai-go"
 
@@ -13,6 +12,9 @@ type responseKeyType int
 
 const responseKey responseKeyType = iota
 
+// 답변 생성에 사용하는 기본 모델.
+const defaultModel = "gpt-5"
+
 func WithResponse(parent context.Context, response string) context.Context {
 	return context.WithValue(parent, responseKey, response)
 }
@@ -22,66 +24,33 @@ func ResponseFrom(ctx context.Context) string {
 	return info
 }
 
-func ResponseGeneration(handler chat.Handler) chat.HandlerFunc {
-	return chat.HandlerFunc(func(chat *chat.Chat) {
-		ctx := chat.Context()
-
-		passages := PassagesFrom(ctx)
-		if len(passages) == 0 {
-			chat = chat.WithContext(WithResponse(ctx, "관련된 정보를 찾을 수 없습니다."))
-			handler.HandleChat(chat)
-			return
-		}
-
-		client := ChatClientFrom(ctx)
-		if client == nil {
-			chat = chat.WithContext(WithResponse(ctx, "답변 생성에 필요한 서비스가 준비되지 않았습니다."))
-			handler.HandleChat(chat)
-			return
-		}
-
-		query := chat.Thread[len(chat.Thread)-1]
-
-		messages := make([]openai.ChatCompletionMessageParamUnion, 0, len(passages)+4)
-		messages = append(messages, openai.ChatCompletionMessageParamUnion{
-			OfSystem: &openai.ChatCompletionSystemMessageParam{
-				Content: openai.ChatCompletionSystemMessageParamContentUnion{
-					OfString: openai.String("참고 자료:"),
-				},
-			},
-		})
-
-		for _, passage := range passages {
+// threadMessages는 스레드의 대화 턴을 LLM 메시지로 변환합니다.
+// 마지막 사용자 메시지는 User 템플릿으로 만든 prompt로 바꿉니다.
+func threadMessages(thread []chat.Message, prompt string) []openai.ChatCompletionMessageParamUnion {
+	messages := make([]openai.ChatCompletionMessageParamUnion, 0, len(thread))
+	for i, m := range thread {
+		switch m.Role {
+		case chat.RoleAssistant:
 			messages = append(messages, openai.ChatCompletionMessageParamUnion{
-				OfSystem: &openai.ChatCompletionSystemMessageParam{
-					Content: openai.ChatCompletionSystemMessageParamContentUnion{
-						OfString: openai.String(string(passage.Content)),
+				OfAssistant: &openai.ChatCompletionAssistantMessageParam{
+					Content: openai.ChatCompletionAssistantMessageParamContentUnion{
+						OfString: openai.String(m.Text),
 					},
 				},
 			})
-		}
-
-		messages = append(messages, openai.ChatCompletionMessageParamUnion{
-			OfUser: &openai.ChatCompletionUserMessageParam{
-				Content: openai.ChatCompletionUserMessageParamContentUnion{
-					OfString: openai.String("참고 자료를 바탕으로 다음 질문에 답해주세요. : " + query),
+		default:
+			text := m.Text
+			if i == len(thread)-1 {
+				text = prompt
+			}
+			messages = append(messages, openai.ChatCompletionMessageParamUnion{
+				OfUser: &openai.ChatCompletionUserMessageParam{
+					Content: openai.ChatCompletionUserMessageParamContentUnion{
+						OfString: openai.String(text),
+					},
 				},
-			},
-		})
-
-		resp, err := client.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
-			Messages: messages,
-			Model:    "gpt-5",
-		})
-
-		if err != nil || len(resp.Choices) == 0 {
-			slog.Error("failed to generate response", "error", err)
-			chat = chat.WithContext(WithResponse(ctx, "답변 생성에 실패했습니다."))
-			handler.HandleChat(chat)
-			return
+			})
 		}
-
-		ctx = WithResponse(ctx, resp.Choices[0].Message.Content)
-		handler.HandleChat(chat.WithContext(ctx))
-	})
+	}
+	return messages
 }
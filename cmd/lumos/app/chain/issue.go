@@ -0,0 +1,235 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+	"github.com/joyfuldevs/project-lumos/gen/go/retrieval/issue/v1"
+)
+
+type Issue = issue.Issue
+
+// IssueSource는 이슈 조회에 실패했을 때 FailedSources에 기록하는 검색 서비스 이름입니다.
+const IssueSource = "issue"
+
+// 이슈 검색 서비스를 사용하지 않아 이슈를 조회할 수 없습니다.
+var errIssueRetrievalDisabled = errors.New("issue retrieval is disabled")
+
+// withIssueFailure는 이슈 조회에 실패했음을 검색 결과에 기록합니다.
+func withIssueFailure(ctx context.Context, err error) context.Context {
+	results := slices.Clone(RetrievalResultsFrom(ctx))
+	results = append(results, RetrievalResult{Source: IssueSource, Err: err})
+	return WithRetrievalResults(ctx, results...)
+}
+
+// issueLookupFailed는 이슈 조회에 실패했는지 여부를 반환합니다.
+func issueLookupFailed(ctx context.Context) bool {
+	return slices.Contains(FailedSources(RetrievalResultsFrom(ctx)), IssueSource)
+}
+
+type issueKeyType int
+
+const issueKey issueKeyType = iota
+
+// WithIssues는 IssueEnrichment가 조회한 이슈 목록을 저장합니다.
+func WithIssues(parent context.Context, issues ...*Issue) context.Context {
+	return context.WithValue(parent, issueKey, issues)
+}
+
+func IssuesFrom(ctx context.Context) []*Issue {
+	info, _ := ctx.Value(issueKey).([]*Issue)
+	return info
+}
+
+// IssueClient는 이슈 검색 서비스 클라이언트입니다.
+type IssueClient interface {
+	RetrievalIssuesV1(ctx context.Context, keys []string) ([]*Issue, error)
+}
+
+// IssueEnrichment는 상위 topK개 패시지의 이슈를 조회하여 패시지 내용을 이슈의 본문과 댓글로 대체합니다.
+//
+// 검색으로 찾은 패시지는 이슈의 일부 내용만 담고 있으므로, 답변 생성에 댓글이나 해결 내용까지
+// 참고할 수 있도록 합니다. 조회에 실패한 이슈는 원래 패시지를 그대로 사용합니다.
+//
+// 상세 검색에서 이슈 정보로 확인할 조건을 지정했다면 IssueFiltering이 조건을 확인할 수 있도록
+// 모든 패시지의 이슈를 조회합니다. 조회한 이슈는 WithIssues로 저장하며, 조회에 실패하면
+// IssueFiltering이 조건을 확인하지 못했음을 알 수 있도록 FailedSources에 IssueSource를 기록합니다.
+func IssueEnrichment(handler chat.Handler, client IssueClient, topK int, timeout time.Duration) chat.HandlerFunc {
+	return chat.HandlerFunc(func(chat *chat.Chat) {
+		ctx := chat.Context()
+
+		passages := PassagesFrom(ctx)
+		filtering := SearchFilterFrom(ctx).needsIssue()
+		if filtering && client == nil && len(passages) > 0 {
+			handler.HandleChat(chat.WithContext(withIssueFailure(ctx, errIssueRetrievalDisabled)))
+			return
+		}
+		if client == nil || (topK <= 0 && !filtering) || len(passages) == 0 {
+			handler.HandleChat(chat)
+			return
+		}
+
+		n := min(topK, len(passages))
+		if filtering {
+			n = len(passages)
+		}
+
+		index := make(map[string]int, n)
+		keys := make([]string, 0, n)
+		for i, p := range passages[:n] {
+			key := ParsePassage(p).Key
+			if key == "" {
+				continue
+			}
+			if _, ok := index[key]; !ok {
+				index[key] = i
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			handler.HandleChat(chat)
+			return
+		}
+
+		issues, err := retrieveIssues(ctx, client, keys, timeout)
+		if err != nil {
+			slog.Warn("failed to retrieve issues", slog.Any("keys", keys), slog.Any("error", err))
+			if filtering {
+				chat = chat.WithContext(withIssueFailure(ctx, err))
+			}
+			handler.HandleChat(chat)
+			return
+		}
+
+		enriched := make([]*Passage, len(passages))
+		copy(enriched, passages)
+		for _, i := range issues {
+			idx, ok := index[i.Key]
+			if !ok {
+				continue
+			}
+			enriched[idx] = issuePassage(i, passages[idx].Score)
+		}
+
+		ctx = WithIssues(ctx, issues...)
+		handler.HandleChat(chat.WithContext(WithPassages(ctx, enriched...)))
+	})
+}
+
+func retrieveIssues(ctx context.Context, client IssueClient, keys []string, timeout time.Duration) ([]*Issue, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return client.RetrievalIssuesV1(ctx, keys)
+}
+
+// issuePassage는 이슈의 본문과 댓글을 Dense 검색 결과와 같은 형식의 패시지로 만듭니다.
+func issuePassage(i *Issue, score float32) *Passage {
+	var b strings.Builder
+	b.WriteString(i.Content)
+	if len(i.Comments) > 0 {
+		b.WriteString("\n\n댓글:")
+		for _, c := range i.Comments {
+			b.WriteString("\n- ")
+			b.WriteString(c)
+		}
+	}
+
+	content, _ := json.Marshal(denseContent{
+		Score:   score,
+		Key:     i.Key,
+		Title:   i.Title,
+		Content: b.String(),
+	})
+	return &Passage{Score: score, Content: content}
+}
+
+// 한 번에 직접 조회할 최대 이슈 수.
+const maxLookupIssues = 5
+
+// IssueKeyLookup은 질문에 언급된 이슈 키의 이슈를 직접 조회하여 가장 앞에 고정합니다.
+//
+// projects가 비어있지 않으면 해당 프로젝트의 이슈 키만 조회합니다. 조회한 이슈와 같은 이슈의
+// 검색 결과는 제외되므로, 특정 이슈에 대한 질문은 항상 그 이슈를 바탕으로 답변합니다.
+// 상세 검색에서 조건을 지정했다면 조건에 맞는 이슈만 고정합니다.
+func IssueKeyLookup(handler chat.Handler, client IssueClient, projects []string, timeout time.Duration) chat.HandlerFunc {
+	return chat.HandlerFunc(func(chat *chat.Chat) {
+		ctx := chat.Context()
+
+		keys := mentionedIssueKeys(projects, chat.Latest().Text, queryOf(chat))
+		if client == nil || len(keys) == 0 {
+			handler.HandleChat(chat)
+			return
+		}
+
+		issues, err := retrieveIssues(ctx, client, keys, timeout)
+		if err != nil {
+			slog.Warn("failed to look up mentioned issues", slog.Any("keys", keys), slog.Any("error", err))
+			handler.HandleChat(chat)
+			return
+		}
+		filter := SearchFilterFrom(ctx)
+		issues = slices.DeleteFunc(issues, func(i *Issue) bool {
+			return !filter.allows(i)
+		})
+		if len(issues) == 0 {
+			handler.HandleChat(chat)
+			return
+		}
+
+		passages := PassagesFrom(ctx)
+
+		// 고정한 이슈가 검색 결과보다 낮은 점수로 표시되지 않도록 최고 점수를 사용한다.
+		var score float32 = 1
+		if len(passages) > 0 {
+			score = max(score, passages[0].Score)
+		}
+
+		pinned := make(map[string]bool, len(issues))
+		result := make([]*Passage, 0, len(issues)+len(passages))
+		for _, i := range issues {
+			pinned[i.Key] = true
+			result = append(result, issuePassage(i, score))
+		}
+		for _, p := range passages {
+			if !pinned[ParsePassage(p).Key] {
+				result = append(result, p)
+			}
+		}
+
+		slog.Info("pinned mentioned issues", slog.Any("keys", keys), slog.Int("found", len(issues)))
+		handler.HandleChat(chat.WithContext(WithPassages(ctx, result...)))
+	})
+}
+
+var issueKeyPattern = regexp.MustCompile(`\b[A-Z][A-Z0-9]+-[0-9]+\b`)
+
+// mentionedIssueKeys는 texts에 언급된 이슈 키를 처음 등장한 순서대로 반환합니다.
+func mentionedIssueKeys(projects []string, texts ...string) []string {
+	var (
+		keys []string
+		seen = make(map[string]bool)
+	)
+	for _, text := range texts {
+		for _, key := range issueKeyPattern.FindAllString(text, -1) {
+			if seen[key] || (len(projects) > 0 && !inProjects(key, projects)) {
+				continue
+			}
+			seen[key] = true
+			keys = append(keys, key)
+			if len(keys) == maxLookupIssues {
+				return keys
+			}
+		}
+	}
+	return keys
+}
@@ -0,0 +1,141 @@
+package chain_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chain"
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+)
+
+// lookupClient는 요청받은 이슈 키를 기록하고 issues 중 요청받은 이슈를 반환합니다.
+type lookupClient struct {
+	issues map[string]*chain.Issue
+	keys   []string
+}
+
+func (c *lookupClient) RetrievalIssuesV1(ctx context.Context, keys []string) ([]*chain.Issue, error) {
+	c.keys = keys
+	var issues []*chain.Issue
+	for _, key := range keys {
+		if i, ok := c.issues[key]; ok {
+			issues = append(issues, i)
+		}
+	}
+	return issues, nil
+}
+
+func TestIssueKeyLookupKeys(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		projects []string
+		question string
+		want     []string
+	}{
+		{
+			desc:     "keys in order of appearance",
+			question: "PAY-12 이슈와 AUTH-3 이슈의 차이가 뭐야?",
+			want:     []string{"PAY-12", "AUTH-3"},
+		},
+		{
+			desc:     "configured projects",
+			projects: []string{"PAY"},
+			question: "PAY-12 이슈와 AUTH-3 이슈, PAY-7 이슈를 비교해줘",
+			want:     []string{"PAY-12", "PAY-7"},
+		},
+		{
+			desc:     "duplicated keys",
+			question: "PAY-12 상태 어때? 어제 본 PAY-12가 맞아?",
+			want:     []string{"PAY-12"},
+		},
+		{
+			desc:     "at most five keys",
+			question: "PAY-1 PAY-2 PAY-3 PAY-4 PAY-5 PAY-6 PAY-7",
+			want:     []string{"PAY-1", "PAY-2", "PAY-3", "PAY-4", "PAY-5"},
+		},
+		{
+			desc:     "lowercase and embedded keys",
+			question: "abc-1, Pay-2, XABC-12x, 3PAY-4, PAY-5a, PAY_6, PAY-",
+			want:     nil,
+		},
+		{
+			desc:     "key with punctuation",
+			question: "(PAY-12), \"AUTH-3\"의 원인은?",
+			want:     []string{"PAY-12", "AUTH-3"},
+		},
+		{
+			desc:     "no keys",
+			question: "결제 승인이 실패하는 원인",
+			want:     nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			client := &lookupClient{}
+			handler := chain.IssueKeyLookup(chat.HandlerFunc(func(c *chat.Chat) {}), client, tc.projects, 0)
+
+			c := &chat.Chat{Thread: []chat.Message{{Role: chat.RoleUser, Text: tc.question}}}
+			handler.HandleChat(c.WithContext(context.Background()))
+
+			if !slices.Equal(client.keys, tc.want) {
+				t.Errorf("got keys %q, want %q", client.keys, tc.want)
+			}
+		})
+	}
+}
+
+func TestIssueKeyLookupPinning(t *testing.T) {
+	client := &lookupClient{issues: map[string]*chain.Issue{
+		"PAY-1":  {Key: "PAY-1", Title: "pinned", Status: "Done", Created: "2025-01-01T09:00:00+09:00", Updated: "2025-01-02T09:00:00+09:00"},
+		"PAY-2":  {Key: "PAY-2", Title: "pinned", Status: "In Progress", Created: "2025-01-01T09:00:00+09:00", Updated: "2025-01-02T09:00:00+09:00"},
+		"AUTH-1": {Key: "AUTH-1", Title: "pinned", Status: "Done", Created: "2025-01-01T09:00:00+09:00", Updated: "2025-01-02T09:00:00+09:00"},
+	}}
+	passages := []*chain.Passage{
+		issuePassage("PAY-3", "searched", 0.9),
+		issuePassage("PAY-1", "searched", 0.8),
+	}
+
+	testCases := []struct {
+		desc   string
+		filter *chain.SearchFilter
+		want   []string
+	}{
+		{
+			desc: "mentioned issues first",
+			want: []string{"PAY-1:pinned", "PAY-2:pinned", "AUTH-1:pinned", "PAY-3:searched"},
+		},
+		{
+			desc:   "status filter",
+			filter: &chain.SearchFilter{Status: "Done"},
+			want:   []string{"PAY-1:pinned", "AUTH-1:pinned", "PAY-3:searched"},
+		},
+		{
+			desc:   "project filter",
+			filter: &chain.SearchFilter{Projects: []string{"PAY"}},
+			want:   []string{"PAY-1:pinned", "PAY-2:pinned", "PAY-3:searched"},
+		},
+		{
+			desc:   "period filter",
+			filter: &chain.SearchFilter{Since: "2025-02-01"},
+			want:   []string{"PAY-3:searched", "PAY-1:searched"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var got []*chain.Passage
+			handler := chain.IssueKeyLookup(chat.HandlerFunc(func(c *chat.Chat) {
+				got = chain.PassagesFrom(c.Context())
+			}), client, nil, 0)
+
+			ctx := chain.WithPassages(context.Background(), passages...)
+			ctx = chain.WithSearchFilter(ctx, tc.filter)
+			c := &chat.Chat{Thread: []chat.Message{{Role: chat.RoleUser, Text: "PAY-1, PAY-2, AUTH-1 이슈 요약해줘"}}}
+			handler.HandleChat(c.WithContext(ctx))
+
+			if keys := keysOf(got); !slices.Equal(keys, tc.want) {
+				t.Errorf("got %q, want %q", keys, tc.want)
+			}
+		})
+	}
+}
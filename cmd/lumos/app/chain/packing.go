@@ -0,0 +1,217 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+)
+
+// 잘라낸 패시지로 채울 만큼 남은 예산이 이보다 작으면 패시지를 버린다.
+const minChunkTokens = 64
+
+type droppedPassagesKeyType int
+
+const droppedPassagesKey droppedPassagesKeyType = iota
+
+// WithDroppedPassages는 토큰 예산을 넘어 답변 생성에서 제외된 패시지를 저장합니다.
+func WithDroppedPassages(parent context.Context, passages ...*Passage) context.Context {
+	return context.WithValue(parent, droppedPassagesKey, passages)
+}
+
+func DroppedPassagesFrom(ctx context.Context) []*Passage {
+	info, _ := ctx.Value(droppedPassagesKey).([]*Passage)
+	return info
+}
+
+// ContextPacking은 점수 순서대로 패시지를 budget 토큰 안에 채워 넣습니다.
+//
+// 남은 예산보다 큰 패시지는 질의와 가장 관련 있는 부분만 남기고, 그마저 들어가지 않으면
+// 제외합니다. 남은 패시지는 컨텍스트의 패시지를 대체하므로 인용에도 그대로 사용되며,
+// 제외된 패시지는 DroppedPassagesFrom으로 확인할 수 있습니다.
+func ContextPacking(handler chat.Handler, budget int) chat.HandlerFunc {
+	return chat.HandlerFunc(func(chat *chat.Chat) {
+		ctx := chat.Context()
+
+		passages := PassagesFrom(ctx)
+		if budget <= 0 || len(passages) == 0 {
+			handler.HandleChat(chat)
+			return
+		}
+
+		terms := queryTerms(queryOf(chat))
+
+		var (
+			packed    = make([]*Passage, 0, len(passages))
+			dropped   []*Passage
+			used      int
+			truncated int
+		)
+		for _, p := range passages {
+			tokens := estimateTokens(string(p.Content))
+			remaining := budget - used
+			if tokens <= remaining {
+				packed = append(packed, p)
+				used += tokens
+				continue
+			}
+			if remaining >= minChunkTokens {
+				// 이슈 키와 제목을 다시 붙이면서 늘어난 만큼 예산을 넘을 수 있으므로 다시 확인한다.
+				chunk := truncatePassage(p, terms, remaining)
+				if chunkTokens := estimateTokens(string(chunk.GetContent())); chunk != nil && chunkTokens <= remaining {
+					packed = append(packed, chunk)
+					used += chunkTokens
+					truncated++
+					continue
+				}
+			}
+			dropped = append(dropped, p)
+			slog.Info("dropped passage over token budget",
+				slog.String("key", ParsePassage(p).Key),
+				slog.Int("tokens", tokens),
+				slog.Int("remaining", remaining))
+		}
+
+		slog.Info("packed passages",
+			slog.Int("budget", budget),
+			slog.Int("tokens", used),
+			slog.Int("packed", len(packed)),
+			slog.Int("truncated", truncated),
+			slog.Int("dropped", len(dropped)))
+
+		ctx = WithPassages(ctx, packed...)
+		ctx = WithDroppedPassages(ctx, dropped...)
+		handler.HandleChat(chat.WithContext(ctx))
+	})
+}
+
+// estimateTokens는 텍스트의 토큰 수를 대략적으로 계산합니다.
+// 영문은 4글자를 하나의 토큰으로, 한글 등 ASCII가 아닌 문자는 한 글자를 하나의 토큰으로 셉니다.
+func estimateTokens(text string) int {
+	var ascii, others int
+	for _, r := range text {
+		if r < utf8.RuneSelf {
+			ascii++
+		} else {
+			others++
+		}
+	}
+	return (ascii+3)/4 + others
+}
+
+// denseContent는 검색 서비스가 보내는 패시지 내용 형식입니다.
+type denseContent struct {
+	Score   float32
+	Key     string
+	Title   string
+	Content string
+}
+
+// truncatePassage는 패시지 본문에서 질의와 가장 관련 있는 부분만 남겨 limit 토큰 안에 맞춥니다.
+// 인용이 유지되도록 이슈 키와 제목은 그대로 남깁니다.
+func truncatePassage(p *Passage, terms []string, limit int) *Passage {
+	var dense denseContent
+	if err := json.Unmarshal(p.Content, &dense); err == nil && dense.Key != "" {
+		overhead := estimateTokens(string(p.Content)) - estimateTokens(dense.Content)
+		chunk := relevantChunk(dense.Content, terms, limit-overhead)
+		if chunk == "" {
+			return nil
+		}
+		dense.Content = chunk
+		content, err := json.Marshal(dense)
+		if err != nil {
+			return nil
+		}
+		return &Passage{Score: p.Score, Content: content}
+	}
+
+	chunk := relevantChunk(string(p.Content), terms, limit)
+	if chunk == "" {
+		return nil
+	}
+	return &Passage{Score: p.Score, Content: []byte(chunk)}
+}
+
+// relevantChunk는 text에서 limit 토큰 안에 들어가는 연속된 줄 중
+// 질의 단어가 가장 많이 등장하는 부분을 반환합니다.
+func relevantChunk(text string, terms []string, limit int) string {
+	if limit <= 0 {
+		return ""
+	}
+
+	lines := strings.Split(text, "\n")
+	var (
+		best      string
+		bestScore = -1
+	)
+	for start := range lines {
+		var (
+			chunk  strings.Builder
+			tokens int
+		)
+		for _, line := range lines[start:] {
+			lineTokens := estimateTokens(line) + 1
+			if tokens+lineTokens > limit {
+				if chunk.Len() == 0 {
+					// 한 줄이 예산보다 길면 앞부분만 남긴다.
+					chunk.WriteString(truncateTokens(line, limit))
+				}
+				break
+			}
+			if chunk.Len() > 0 {
+				chunk.WriteByte('\n')
+			}
+			chunk.WriteString(line)
+			tokens += lineTokens
+		}
+
+		if score := termScore(chunk.String(), terms); score > bestScore {
+			best, bestScore = chunk.String(), score
+		}
+	}
+	return best
+}
+
+// truncateTokens는 text의 앞부분을 limit 토큰만큼 잘라냅니다.
+func truncateTokens(text string, limit int) string {
+	var ascii, others int
+	for i, r := range text {
+		if r < utf8.RuneSelf {
+			ascii++
+		} else {
+			others++
+		}
+		if (ascii+3)/4+others > limit {
+			return text[:i]
+		}
+	}
+	return text
+}
+
+// queryTerms는 질의를 관련도 계산에 사용할 단어로 나눕니다.
+func queryTerms(query string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-'
+	})
+
+	terms := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if utf8.RuneCountInString(f) >= 2 {
+			terms = append(terms, f)
+		}
+	}
+	return terms
+}
+
+func termScore(text string, terms []string) int {
+	text = strings.ToLower(text)
+	var score int
+	for _, term := range terms {
+		score += strings.Count(text, term)
+	}
+	return score
+}
@@ -0,0 +1,146 @@
+package chain_test
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chain"
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+)
+
+// textPassage는 내용이 text인 패시지를 생성합니다.
+// 한글은 한 글자가 하나의 토큰으로 계산되므로 한글로 토큰 수를 맞춥니다.
+func textPassage(text string) *chain.Passage {
+	return &chain.Passage{Score: 1, Content: []byte(text)}
+}
+
+// heads는 패시지를 구분하기 쉽도록 각 패시지 내용의 첫 글자를 반환합니다.
+func heads(passages []*chain.Passage) []string {
+	s := make([]string, 0, len(passages))
+	for _, p := range passages {
+		r := []rune(string(p.Content))
+		s = append(s, string(r[:min(1, len(r))]))
+	}
+	return s
+}
+
+// pack은 질문 question으로 passages를 budget 토큰 안에 채운 결과와 제외된 패시지를 반환합니다.
+func pack(passages []*chain.Passage, question string, budget int) (packed, dropped []*chain.Passage) {
+	handler := chain.ContextPacking(chat.HandlerFunc(func(c *chat.Chat) {
+		packed = chain.PassagesFrom(c.Context())
+		dropped = chain.DroppedPassagesFrom(c.Context())
+	}), budget)
+
+	c := &chat.Chat{Thread: []chat.Message{{Role: chat.RoleUser, Text: question}}}
+	handler.HandleChat(c.WithContext(chain.WithPassages(context.Background(), passages...)))
+	return packed, dropped
+}
+
+func TestContextPacking(t *testing.T) {
+	passages := []*chain.Passage{
+		textPassage(strings.Repeat("가", 60)),
+		textPassage(strings.Repeat("나", 80)),
+		textPassage(strings.Repeat("다", 30)),
+		textPassage(strings.Repeat("라", 50)),
+	}
+
+	testCases := []struct {
+		desc        string
+		budget      int
+		wantPacked  []string
+		wantDropped []string
+	}{
+		{
+			desc:       "everything fits",
+			budget:     220,
+			wantPacked: []string{"가", "나", "다", "라"},
+		},
+		{
+			desc:       "no budget",
+			budget:     0,
+			wantPacked: []string{"가", "나", "다", "라"},
+		},
+		{
+			// 두 번째 패시지를 잘라 넣을 만큼 예산이 남지 않아 제외하고, 뒤의 작은 패시지로 채운다.
+			desc:        "smaller passages fill the remaining budget",
+			budget:      100,
+			wantPacked:  []string{"가", "다"},
+			wantDropped: []string{"나", "라"},
+		},
+		{
+			desc:        "dropped in score order",
+			budget:      60,
+			wantPacked:  []string{"가"},
+			wantDropped: []string{"나", "다", "라"},
+		},
+		{
+			desc:        "too small for any passage",
+			budget:      20,
+			wantDropped: []string{"가", "나", "다", "라"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			packed, dropped := pack(passages, "질문", tc.budget)
+
+			if got := heads(packed); !slices.Equal(got, tc.wantPacked) {
+				t.Errorf("got packed %q, want %q", got, tc.wantPacked)
+			}
+			if got := heads(dropped); !slices.Equal(got, tc.wantDropped) {
+				t.Errorf("got dropped %q, want %q", got, tc.wantDropped)
+			}
+
+			if tc.budget > 0 {
+				var used int
+				for _, p := range packed {
+					used += len([]rune(string(p.Content)))
+				}
+				if used > tc.budget {
+					t.Errorf("packed %d tokens, want at most %d", used, tc.budget)
+				}
+			}
+		})
+	}
+}
+
+func TestContextPackingTruncation(t *testing.T) {
+	lines := []string{
+		strings.Repeat("가", 100),
+		"결제 " + strings.Repeat("나", 97),
+		strings.Repeat("다", 100),
+	}
+
+	t.Run("keeps the relevant lines", func(t *testing.T) {
+		packed, dropped := pack([]*chain.Passage{textPassage(strings.Join(lines, "\n"))}, "결제 실패", 150)
+
+		if len(packed) != 1 || len(dropped) != 0 {
+			t.Fatalf("got %d packed and %d dropped, want 1 packed", len(packed), len(dropped))
+		}
+		if got := string(packed[0].Content); got != lines[1] {
+			t.Errorf("got %q, want the line mentioning the query", got)
+		}
+	})
+
+	t.Run("keeps the key and title of structured passages", func(t *testing.T) {
+		p := issuePassage("PAY-1", "결제 장애", 1)
+		var content map[string]any
+		_ = json.Unmarshal(p.Content, &content)
+		content["Content"] = strings.Join(lines, "\n")
+		p.Content, _ = json.Marshal(content)
+
+		packed, _ := pack([]*chain.Passage{p}, "결제 실패", 200)
+
+		if len(packed) != 1 {
+			t.Fatalf("got %d packed, want 1", len(packed))
+		}
+		if info := chain.ParsePassage(packed[0]); info.Key != "PAY-1" || info.Title != "결제 장애" {
+			t.Errorf("got %+v, want key and title kept", info)
+		}
+		if !strings.Contains(string(packed[0].Content), "결제 나") || strings.Contains(string(packed[0].Content), "가가") {
+			t.Errorf("got %s, want only the line mentioning the query", packed[0].Content)
+		}
+	})
+}
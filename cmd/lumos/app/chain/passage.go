@@ -2,11 +2,13 @@ package chain
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
+	"sync"
+	"time"
 
 	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
 	"github.com/joyfuldevs/project-lumos/gen/go/retrieval/passage/v1"
-	"github.com/joyfuldevs/project-lumos/pkg/service/retrieval/passage/client"
 )
 
 type Passage = passage.Passage
@@ -24,55 +26,134 @@ func PassagesFrom(ctx context.Context) []*Passage {
 	return info
 }
 
-func PassageRetrieval(handler chat.Handler) chat.HandlerFunc {
-	return chat.HandlerFunc(func(chat *chat.Chat) {
-		ctx := chat.Context()
+// RetrievalResult는 하나의 검색 서비스가 반환한 패시지 목록입니다.
+type RetrievalResult struct {
+	// 검색 서비스 이름.
+	Source string
+	// 점수 내림차순으로 정렬된 패시지 목록.
+	Passages []*Passage
+	// 검색에 실패했거나 제한 시간을 넘긴 경우의 에러.
+	Err error
+}
 
-		query := chat.Thread[len(chat.Thread)-1]
-		passages := make([]*Passage, 0, 20)
-		passages = append(passages, denseRetrieval(ctx, query, 10)...)
-		passages = append(passages, sparseRetrieval(ctx, query, 10)...)
+// FailedSources는 검색에 실패한 서비스 이름 목록을 반환합니다.
+func FailedSources(results []RetrievalResult) []string {
+	var sources []string
+	for _, result := range results {
+		if result.Err != nil {
+			sources = append(sources, result.Source)
+		}
+	}
+	return sources
+}
 
-		// TODO: implement score based ranking
+type retrievalResultKeyType int
 
-		chat = chat.WithContext(WithPassages(ctx, passages...))
+const retrievalResultKey retrievalResultKeyType = iota
 
-		handler.HandleChat(chat)
-	})
+func WithRetrievalResults(parent context.Context, results ...RetrievalResult) context.Context {
+	return context.WithValue(parent, retrievalResultKey, results)
 }
 
-func denseRetrieval(ctx context.Context, query string, limit int32) []*Passage {
-	drClient, err := client.NewClient(
-		client.WithHost("dense-retrieval-service"),
-	)
-	if err != nil {
-		slog.Error("failed to create dense retrieval client", slog.Any("error", err))
-		return nil
-	}
+func RetrievalResultsFrom(ctx context.Context) []RetrievalResult {
+	info, _ := ctx.Value(retrievalResultKey).([]RetrievalResult)
+	return info
+}
 
-	passages, err := drClient.RetrievePassagesV1(ctx, query, limit)
-	if err != nil {
-		slog.Error("failed to retrieve passages", slog.Any("error", err))
-		return nil
-	}
+// PassageClient는 패시지 검색 서비스 클라이언트입니다.
+type PassageClient interface {
+	RetrievePassagesV1(ctx context.Context, query string, limit int32) ([]*Passage, error)
+}
 
-	return passages
+// Retriever는 답변 생성에 사용할 하나의 패시지 검색 서비스입니다.
+type Retriever struct {
+	// 검색 서비스 이름. RetrievalResult의 Source로 사용됩니다.
+	Name string
+	// 검색 서비스 클라이언트.
+	Client PassageClient
+	// 검색 요청의 제한 시간. 0이면 제한하지 않습니다.
+	Timeout time.Duration
+	// 검색할 최대 패시지 수.
+	Limit int32
 }
 
-func sparseRetrieval(ctx context.Context, query string, limit int32) []*Passage {
-	srClient, err := client.NewClient(
-		client.WithHost("sparse-retrieval-service"),
-	)
-	if err != nil {
-		slog.Error("failed to create sparse retrieval client", slog.Any("error", err))
-		return nil
-	}
+// 상세 검색에서 조건을 지정했을 때 검색하고 결합할 패시지 수를 늘리는 배수.
+// 조건에 맞지 않는 패시지는 SearchFiltering과 IssueFiltering에서 제외되므로 더 많이 검색해 둔다.
+const filteredLimitFactor = 3
 
-	passages, err := srClient.RetrievePassagesV1(ctx, query, limit)
-	if err != nil {
-		slog.Error("failed to retrieve passages", slog.Any("error", err))
-		return nil
+// retrieve는 제한 시간 안에 패시지를 검색합니다.
+func (r *Retriever) retrieve(ctx context.Context, query string) ([]*Passage, error) {
+	limit := r.Limit
+	if SearchFilterFrom(ctx).active() {
+		limit *= filteredLimitFactor
+	}
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
 	}
+	return r.Client.RetrievePassagesV1(ctx, query, limit)
+}
+
+// PassageRetrieval은 설정된 검색 서비스에 동시에 질의하여 패시지를 검색합니다.
+//
+// 각 서비스는 자신의 제한 시간 안에서만 기다리며, 일부 서비스가 실패하더라도
+// 나머지 서비스의 결과로 계속 진행합니다. 서비스별 결과와 에러는
+// RetrievalResultsFrom으로 확인할 수 있습니다.
+func PassageRetrieval(handler chat.Handler, retrievers []Retriever) chat.HandlerFunc {
+	return chat.HandlerFunc(func(chat *chat.Chat) {
+		ctx := chat.Context()
 
-	return passages
+		query := queryOf(chat)
+		results := make([]RetrievalResult, len(retrievers))
+
+		var wg sync.WaitGroup
+		for i, r := range retrievers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				passages, err := r.retrieve(ctx, query)
+				if err != nil {
+					slog.Error("failed to retrieve passages", slog.String("source", r.Name), slog.Any("error", err))
+				}
+				results[i] = RetrievalResult{Source: r.Name, Passages: passages, Err: err}
+			}()
+		}
+		wg.Wait()
+
+		if failed := FailedSources(results); len(failed) > 0 {
+			slog.Warn("continuing with partial retrieval results",
+				slog.Any("failed", failed),
+				slog.Int("total", len(results)))
+		}
+
+		var passages []*Passage
+		for _, result := range results {
+			passages = append(passages, result.Passages...)
+		}
+
+		ctx = WithRetrievalResults(ctx, results...)
+		ctx = WithPassages(ctx, passages...)
+		chat = chat.WithContext(ctx)
+
+		handler.HandleChat(chat)
+	})
+}
+
+// PassageInfo는 패시지 내용에서 추출한 이슈 정보입니다.
+type PassageInfo struct {
+	Key   string `json:"key"`
+	Title string `json:"title"`
+}
+
+// ParsePassage는 패시지 내용에서 이슈 키와 제목을 읽습니다.
+//
+// 검색 서비스는 패시지 내용을 키와 제목을 포함한 JSON 형식으로 보냅니다.
+// 형식이 다르면 본문에서 이슈 키를 추측하지 않고 빈 PassageInfo를 반환합니다.
+func ParsePassage(p *Passage) PassageInfo {
+	var info PassageInfo
+	if err := json.Unmarshal(p.Content, &info); err != nil {
+		return PassageInfo{}
+	}
+	return info
 }
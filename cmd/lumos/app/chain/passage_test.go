@@ -0,0 +1,137 @@
+package chain_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chain"
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+)
+
+// passageClient는 미리 정한 패시지나 오류를 반환하는 패시지 검색 서비스 클라이언트입니다.
+type passageClient struct {
+	passages []*chain.Passage
+	err      error
+	// block이 true면 요청의 제한 시간이 지날 때까지 응답하지 않습니다.
+	block bool
+	// 마지막 요청의 검색 패시지 수.
+	limit int32
+}
+
+func (c *passageClient) RetrievePassagesV1(ctx context.Context, query string, limit int32) ([]*chain.Passage, error) {
+	c.limit = limit
+	if c.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return c.passages, c.err
+}
+
+func TestPassageRetrieval(t *testing.T) {
+	dense := []*chain.Passage{issuePassage("PAY-1", "a", 0.9), issuePassage("PAY-2", "b", 0.8)}
+	sparse := []*chain.Passage{issuePassage("PAY-3", "c", 12)}
+	errUnavailable := errors.New("unavailable")
+
+	testCases := []struct {
+		desc         string
+		dense        *passageClient
+		sparse       *passageClient
+		wantPassages []string
+		wantFailed   []string
+		wantErrs     map[string]error
+	}{
+		{
+			desc:         "all sources succeed",
+			dense:        &passageClient{passages: dense},
+			sparse:       &passageClient{passages: sparse},
+			wantPassages: []string{"PAY-1:a", "PAY-2:b", "PAY-3:c"},
+		},
+		{
+			desc:         "one source fails",
+			dense:        &passageClient{passages: dense},
+			sparse:       &passageClient{err: errUnavailable},
+			wantPassages: []string{"PAY-1:a", "PAY-2:b"},
+			wantFailed:   []string{"sparse"},
+			wantErrs:     map[string]error{"sparse": errUnavailable},
+		},
+		{
+			desc:         "one source times out",
+			dense:        &passageClient{block: true},
+			sparse:       &passageClient{passages: sparse},
+			wantPassages: []string{"PAY-3:c"},
+			wantFailed:   []string{"dense"},
+			wantErrs:     map[string]error{"dense": context.DeadlineExceeded},
+		},
+		{
+			desc:         "all sources fail",
+			dense:        &passageClient{block: true},
+			sparse:       &passageClient{err: errUnavailable},
+			wantPassages: []string{},
+			wantFailed:   []string{"dense", "sparse"},
+			wantErrs:     map[string]error{"dense": context.DeadlineExceeded, "sparse": errUnavailable},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var got *chat.Chat
+			handler := chain.PassageRetrieval(chat.HandlerFunc(func(c *chat.Chat) {
+				got = c
+			}), []chain.Retriever{
+				{Name: "dense", Client: tc.dense, Timeout: 10 * time.Millisecond, Limit: 10},
+				// 다른 서비스의 제한 시간이 이 서비스에 영향을 주지 않아야 한다.
+				{Name: "sparse", Client: tc.sparse, Limit: 10},
+			})
+
+			c := &chat.Chat{Thread: []chat.Message{{Role: chat.RoleUser, Text: "결제 실패"}}}
+			handler.HandleChat(c.WithContext(context.Background()))
+
+			ctx := got.Context()
+			if keys := keysOf(chain.PassagesFrom(ctx)); !slices.Equal(keys, tc.wantPassages) {
+				t.Errorf("got passages %q, want %q", keys, tc.wantPassages)
+			}
+
+			results := chain.RetrievalResultsFrom(ctx)
+			if failed := chain.FailedSources(results); !slices.Equal(failed, tc.wantFailed) {
+				t.Errorf("got failed sources %q, want %q", failed, tc.wantFailed)
+			}
+			if len(results) != 2 || results[0].Source != "dense" || results[1].Source != "sparse" {
+				t.Fatalf("got %+v, want results in retriever order", results)
+			}
+			for _, result := range results {
+				if want := tc.wantErrs[result.Source]; !errors.Is(result.Err, want) {
+					t.Errorf("%s: got error %v, want %v", result.Source, result.Err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPassageRetrievalLimit(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		filter *chain.SearchFilter
+		want   int32
+	}{
+		{desc: "no filter", want: 10},
+		{desc: "inactive filter", filter: &chain.SearchFilter{}, want: 10},
+		{desc: "active filter", filter: &chain.SearchFilter{Status: "Done"}, want: 30},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			client := &passageClient{}
+			handler := chain.PassageRetrieval(chat.HandlerFunc(func(c *chat.Chat) {}), []chain.Retriever{
+				{Name: "dense", Client: client, Limit: 10},
+			})
+
+			c := &chat.Chat{Thread: []chat.Message{{Role: chat.RoleUser, Text: "결제 실패"}}}
+			handler.HandleChat(c.WithContext(chain.WithSearchFilter(context.Background(), tc.filter)))
+
+			if client.limit != tc.want {
+				t.Errorf("got limit %d, want %d", client.limit, tc.want)
+			}
+		})
+	}
+}
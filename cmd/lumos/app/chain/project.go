@@ -0,0 +1,112 @@
+package chain
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/thread"
+)
+
+type contextChannelKeyType int
+
+const contextChannelKey contextChannelKeyType = iota
+
+// WithContextChannel은 사용자가 어시스턴트 스레드를 열었을 때 보고 있던 채널 ID를 저장합니다.
+func WithContextChannel(parent context.Context, channelID string) context.Context {
+	return context.WithValue(parent, contextChannelKey, channelID)
+}
+
+func ContextChannelFrom(ctx context.Context) string {
+	info, _ := ctx.Value(contextChannelKey).(string)
+	return info
+}
+
+// ThreadContextInit은 스레드 상태 저장소에서 사용자가 보고 있는 채널을 찾아 컨텍스트에 저장합니다.
+func ThreadContextInit(handler chat.Handler, store *thread.Store) chat.HandlerFunc {
+	return chat.HandlerFunc(func(c *chat.Chat) {
+		ctx := c.Context()
+		if state, ok := store.Get(c.Channel, c.Timestamp); ok && state.ContextChannelID != "" {
+			c = c.WithContext(WithContextChannel(ctx, state.ContextChannelID))
+		} else if c.Kind == chat.KindMention || c.Kind == chat.KindMessageAction {
+			// 채널에서 시작된 대화는 그 채널이 곧 사용자가 보고 있는 채널이다.
+			c = c.WithContext(WithContextChannel(ctx, c.Channel))
+		}
+		handler.HandleChat(c)
+	})
+}
+
+type preferredProjectsKeyType int
+
+const preferredProjectsKey preferredProjectsKeyType = iota
+
+// WithPreferredProjects는 검색 결과에서 우선할 Jira 프로젝트 키 목록을 저장합니다.
+func WithPreferredProjects(parent context.Context, projects ...string) context.Context {
+	return context.WithValue(parent, preferredProjectsKey, projects)
+}
+
+func PreferredProjectsFrom(ctx context.Context) []string {
+	info, _ := ctx.Value(preferredProjectsKey).([]string)
+	return info
+}
+
+// ProjectPreference는 사용자가 보고 있는 채널에 연결된 Jira 프로젝트의 이슈를 우선하도록
+// 검색 서비스별 결과의 점수를 boost 배율만큼 높이고 다시 정렬합니다.
+//
+// 검색 서비스에 따라 점수가 음수일 수 있으므로 점수에 boost를 곱하지 않고
+// 점수의 절댓값에 비례하여 더합니다. 양수 점수는 boost를 곱한 것과 같습니다.
+//
+// 보고 있는 채널 정보가 없으면 대화가 이루어진 채널을 기준으로 합니다.
+func ProjectPreference(handler chat.Handler, channelProjects map[string][]string, boost float64) chat.HandlerFunc {
+	return chat.HandlerFunc(func(chat *chat.Chat) {
+		ctx := chat.Context()
+
+		channel := ContextChannelFrom(ctx)
+		if channel == "" {
+			channel = chat.Channel
+		}
+		projects := channelProjects[channel]
+		if len(projects) == 0 {
+			handler.HandleChat(chat)
+			return
+		}
+		ctx = WithPreferredProjects(ctx, projects...)
+
+		results := RetrievalResultsFrom(ctx)
+		boosted := make([]RetrievalResult, 0, len(results))
+		for _, result := range results {
+			passages := make([]*Passage, 0, len(result.Passages))
+			for _, p := range result.Passages {
+				if inProjects(ParsePassage(p).Key, projects) {
+					p = &Passage{Score: boostScore(p.Score, boost), Content: p.Content}
+				}
+				passages = append(passages, p)
+			}
+			slices.SortStableFunc(passages, func(a, b *Passage) int {
+				return cmp.Compare(b.Score, a.Score)
+			})
+			boosted = append(boosted, RetrievalResult{Source: result.Source, Passages: passages, Err: result.Err})
+		}
+
+		chat = chat.WithContext(WithRetrievalResults(ctx, boosted...))
+		handler.HandleChat(chat)
+	})
+}
+
+// boostScore는 boost가 1보다 크면 score의 부호와 관계없이 점수를 높입니다.
+func boostScore(score float32, boost float64) float32 {
+	s := float64(score)
+	return float32(s + math.Abs(s)*(boost-1))
+}
+
+// inProjects는 이슈 키가 주어진 프로젝트 중 하나에 속하는지 확인합니다.
+func inProjects(issueKey string, projects []string) bool {
+	project, _, ok := strings.Cut(issueKey, "-")
+	if !ok {
+		return false
+	}
+	return slices.Contains(projects, project)
+}
@@ -0,0 +1,92 @@
+package chain_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chain"
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+)
+
+func TestProjectPreference(t *testing.T) {
+	channelProjects := map[string][]string{"C1": {"PAY"}}
+
+	testCases := []struct {
+		desc       string
+		channel    string
+		passages   []*chain.Passage
+		want       []string
+		wantScores []float32
+	}{
+		{
+			desc:    "positive scores",
+			channel: "C1",
+			passages: []*chain.Passage{
+				issuePassage("AUTH-1", "a", 10),
+				issuePassage("PAY-1", "b", 8),
+			},
+			want:       []string{"PAY-1:b", "AUTH-1:a"},
+			wantScores: []float32{12, 10},
+		},
+		{
+			desc:    "negative scores",
+			channel: "C1",
+			passages: []*chain.Passage{
+				issuePassage("AUTH-1", "a", -1.5),
+				issuePassage("PAY-1", "b", -2),
+				issuePassage("PAY-2", "c", -4),
+			},
+			want:       []string{"PAY-1:b", "AUTH-1:a", "PAY-2:c"},
+			wantScores: []float32{-1, -1.5, -2},
+		},
+		{
+			desc:    "zero score",
+			channel: "C1",
+			passages: []*chain.Passage{
+				issuePassage("AUTH-1", "a", 0),
+				issuePassage("PAY-1", "b", 0),
+			},
+			want:       []string{"AUTH-1:a", "PAY-1:b"},
+			wantScores: []float32{0, 0},
+		},
+		{
+			desc:    "channel without projects",
+			channel: "C2",
+			passages: []*chain.Passage{
+				issuePassage("AUTH-1", "a", -1.5),
+				issuePassage("PAY-1", "b", -2),
+			},
+			want:       []string{"AUTH-1:a", "PAY-1:b"},
+			wantScores: []float32{-1.5, -2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var got []chain.RetrievalResult
+			handler := chain.ProjectPreference(chat.HandlerFunc(func(c *chat.Chat) {
+				got = chain.RetrievalResultsFrom(c.Context())
+			}), channelProjects, 1.5)
+
+			ctx := chain.WithRetrievalResults(context.Background(), chain.RetrievalResult{
+				Source:   "dense",
+				Passages: tc.passages,
+			})
+			handler.HandleChat((&chat.Chat{Channel: tc.channel}).WithContext(ctx))
+
+			if len(got) != 1 {
+				t.Fatalf("got %d results, want 1", len(got))
+			}
+			if keys := keysOf(got[0].Passages); !slices.Equal(keys, tc.want) {
+				t.Errorf("got %q, want %q", keys, tc.want)
+			}
+			scores := make([]float32, 0, len(got[0].Passages))
+			for _, p := range got[0].Passages {
+				scores = append(scores, p.Score)
+			}
+			if !slices.Equal(scores, tc.wantScores) {
+				t.Errorf("got scores %v, want %v", scores, tc.wantScores)
+			}
+		})
+	}
+}
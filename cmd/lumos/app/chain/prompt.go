@@ -0,0 +1,182 @@
+package chain
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/shared"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+)
+
+// 기본 프롬프트 템플릿.
+const (
+	// 참고 자료 앞에 두는 시스템 메시지.
+	DefaultSystemTemplate = `참고 자료:`
+	// 참고 자료를 하나의 시스템 메시지로 합칠 때 사용하는 시스템 메시지.
+	DefaultCombinedSystemTemplate = `참고 자료:
+{{- range .Passages}}
+---
+{{.Content}}
+{{- end}}`
+	// 마지막 사용자 메시지.
+	DefaultUserTemplate = `참고 자료를 바탕으로 다음 질문에 답해주세요. : {{.Query}}`
+)
+
+// 일부 검색 서비스가 응답하지 않았을 때 덧붙이는 시스템 메시지.
+const degradedNotice = "일부 검색 서비스가 응답하지 않아 참고 자료가 충분하지 않을 수 있습니다. 답변 끝에 검색 결과가 불완전할 수 있다는 점을 짧게 언급해주세요."
+
+// 사용자가 보고 있는 채널에 연결된 프로젝트를 전달할 때 앞에 두는 시스템 메시지.
+const preferenceNotice = "사용자가 보고 있는 채널은 다음 Jira 프로젝트와 관련되어 있습니다. 질문이 다른 프로젝트를 가리키지 않으면 이 프로젝트의 이슈를 우선하여 답변해주세요: "
+
+// 상세 검색에서 지정한 조건을 전달할 때 앞에 두는 시스템 메시지.
+const constraintNotice = "사용자가 상세 검색에서 다음 조건을 지정했고, 참고 자료는 조건에 맞는 이슈로만 구성되어 있습니다. 참고 자료가 없으면 조건에 맞는 이슈를 찾지 못했다고 답해주세요."
+
+// 이슈 정보를 조회하지 못해 상세 검색 조건을 적용하지 못했을 때 앞에 두는 시스템 메시지.
+const unfilteredNotice = "사용자가 상세 검색에서 다음 조건을 지정했지만 이슈 정보를 조회하지 못해 조건을 적용하지 못했습니다. 참고 자료에는 조건에 맞지 않는 이슈가 포함될 수 있으니 조건에 맞는다고 단정하지 말아주세요."
+
+// PromptData는 프롬프트 템플릿에서 사용할 수 있는 값입니다.
+type PromptData struct {
+	// 검색과 답변에 사용하는 질의.
+	Query string
+	// 답변에 참고할 패시지 목록.
+	Passages []PromptPassage
+	// 스레드 대화 기록. 오래된 메시지부터 순서대로 저장됩니다.
+	Thread []chat.Message
+	// 질문한 사용자 ID.
+	User string
+	// 검색에 실패하여 결과에 포함되지 않은 검색 서비스 이름 목록.
+	FailedSources []string
+	// 상세 검색에서 지정한 조건 목록. e.g., "상태: Done"
+	Constraints []string
+	// 이슈 정보를 조회하지 못해 상세 검색 조건을 적용하지 못했는지 여부.
+	Unfiltered bool
+	// 사용자가 보고 있는 채널에 연결되어 우선하는 Jira 프로젝트 키 목록.
+	PreferredProjects []string
+}
+
+// PromptPassage는 프롬프트 템플릿에서 사용하는 패시지입니다.
+type PromptPassage struct {
+	Key     string
+	Title   string
+	Content string
+	Score   float32
+}
+
+// Generation은 답변 생성에 사용할 모델과 프롬프트를 설정합니다.
+type Generation struct {
+	// 답변 생성에 사용할 모델.
+	Model string
+	// 샘플링 온도. nil이면 모델의 기본값을 사용합니다.
+	Temperature *float64
+	// 생성할 최대 토큰 수. 0이면 제한하지 않습니다.
+	MaxTokens int64
+	// 추론 모델의 추론 강도. e.g., low, medium, high
+	ReasoningEffort string
+
+	// CombinePassages가 true이면 패시지를 System 템플릿으로 하나의 시스템 메시지에 담고,
+	// false이면 System 메시지 뒤에 패시지마다 시스템 메시지를 추가합니다.
+	CombinePassages bool
+	// 시스템 메시지 템플릿.
+	System *template.Template
+	// 마지막 사용자 메시지 템플릿.
+	User *template.Template
+}
+
+// DefaultGeneration은 기본 모델과 프롬프트를 사용하는 설정을 반환합니다.
+func DefaultGeneration() *Generation {
+	return &Generation{
+		Model:  defaultModel,
+		System: template.Must(ParsePromptTemplate("system", DefaultSystemTemplate)),
+		User:   template.Must(ParsePromptTemplate("user", DefaultUserTemplate)),
+	}
+}
+
+// ParsePromptTemplate은 PromptData를 사용하는 프롬프트 템플릿을 파싱합니다.
+func ParsePromptTemplate(name, text string) (*template.Template, error) {
+	return template.New(name).Parse(text)
+}
+
+// completionParams는 참고 자료와 스레드 대화를 포함한 LLM 요청을 생성합니다.
+func (g *Generation) completionParams(c *chat.Chat, passages []*Passage) (openai.ChatCompletionNewParams, error) {
+	data := promptData(c, passages)
+
+	system, err := execute(g.System, data)
+	if err != nil {
+		return openai.ChatCompletionNewParams{}, err
+	}
+	user, err := execute(g.User, data)
+	if err != nil {
+		return openai.ChatCompletionNewParams{}, err
+	}
+
+	messages := make([]openai.ChatCompletionMessageParamUnion, 0, len(passages)+len(c.Thread)+1)
+	messages = append(messages, openai.SystemMessage(system))
+	if !g.CombinePassages {
+		for _, p := range data.Passages {
+			messages = append(messages, openai.SystemMessage(p.Content))
+		}
+	}
+	if len(data.FailedSources) > 0 {
+		messages = append(messages, openai.SystemMessage(degradedNotice))
+	}
+	if len(data.PreferredProjects) > 0 {
+		messages = append(messages, openai.SystemMessage(preferenceNotice+strings.Join(data.PreferredProjects, ", ")))
+	}
+	if len(data.Constraints) > 0 {
+		notice := constraintNotice
+		if data.Unfiltered {
+			notice = unfilteredNotice
+		}
+		messages = append(messages, openai.SystemMessage(notice+"\n- "+strings.Join(data.Constraints, "\n- ")))
+	}
+	messages = append(messages, threadMessages(c.Thread, user)...)
+
+	params := openai.ChatCompletionNewParams{
+		Messages: messages,
+		Model:    g.Model,
+	}
+	if g.Temperature != nil {
+		params.Temperature = openai.Float(*g.Temperature)
+	}
+	if g.MaxTokens > 0 {
+		params.MaxCompletionTokens = openai.Int(g.MaxTokens)
+	}
+	if g.ReasoningEffort != "" {
+		params.ReasoningEffort = shared.ReasoningEffort(g.ReasoningEffort)
+	}
+
+	return params, nil
+}
+
+func promptData(c *chat.Chat, passages []*Passage) PromptData {
+	data := PromptData{
+		Query:             queryOf(c),
+		Passages:          make([]PromptPassage, 0, len(passages)),
+		Thread:            c.Thread,
+		User:              c.User,
+		FailedSources:     FailedSources(RetrievalResultsFrom(c.Context())),
+		Constraints:       SearchFilterFrom(c.Context()).Constraints(),
+		Unfiltered:        UnfilteredFrom(c.Context()),
+		PreferredProjects: PreferredProjectsFrom(c.Context()),
+	}
+	for _, p := range passages {
+		info := ParsePassage(p)
+		data.Passages = append(data.Passages, PromptPassage{
+			Key:     info.Key,
+			Title:   info.Title,
+			Content: string(p.Content),
+			Score:   p.Score,
+		})
+	}
+	return data
+}
+
+func execute(tmpl *template.Template, data PromptData) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,103 @@
+package chain
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/openai/openai-go"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+)
+
+const rewritePrompt = `당신은 Jira 이슈 검색을 위한 검색 질의를 작성하는 도우미입니다.
+대화 기록을 참고하여 마지막 사용자 질문을 이전 대화 없이도 이해할 수 있는 하나의 독립적인 검색 질의로 다시 작성하세요.
+대명사나 생략된 대상은 대화에 등장한 구체적인 이름, 이슈 키, 기능으로 바꾸세요.
+설명 없이 검색 질의만 출력하세요.`
+
+type queryKeyType int
+
+const queryKey queryKeyType = iota
+
+// WithQuery는 검색과 답변 생성에 사용할 질의를 저장합니다.
+func WithQuery(parent context.Context, query string) context.Context {
+	return context.WithValue(parent, queryKey, query)
+}
+
+func QueryFrom(ctx context.Context) string {
+	info, _ := ctx.Value(queryKey).(string)
+	return info
+}
+
+// queryOf는 재작성된 질의가 있으면 반환하고, 없으면 스레드의 마지막 메시지를 반환합니다.
+func queryOf(c *chat.Chat) string {
+	if query := QueryFrom(c.Context()); query != "" {
+		return query
+	}
+	return c.Latest().Text
+}
+
+// QueryRewrite는 스레드 대화 기록을 바탕으로 마지막 메시지를 독립적인 검색 질의로 재작성합니다.
+// 재작성된 질의는 컨텍스트에 저장되며, 스레드의 원본 메시지는 그대로 유지됩니다.
+// model이 비어있으면 기본 모델을 사용합니다.
+func QueryRewrite(handler chat.Handler, model string) chat.HandlerFunc {
+	if model == "" {
+		model = defaultModel
+	}
+	return chat.HandlerFunc(func(c *chat.Chat) {
+		ctx := c.Context()
+		latest := c.Latest().Text
+
+		// 이전 질문이 없다면 재작성할 필요가 없다.
+		client := ChatClientFrom(ctx)
+		if client == nil || !hasPreviousQuestion(c.Thread) {
+			handler.HandleChat(c.WithContext(WithQuery(ctx, latest)))
+			return
+		}
+
+		var history strings.Builder
+		for _, m := range c.Thread[:len(c.Thread)-1] {
+			switch m.Role {
+			case chat.RoleAssistant:
+				history.WriteString("봇: ")
+			default:
+				history.WriteString("사용자: ")
+			}
+			history.WriteString(m.Text)
+			history.WriteString("\n")
+		}
+
+		resp, err := client.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
+			Messages: []openai.ChatCompletionMessageParamUnion{
+				openai.SystemMessage(rewritePrompt),
+				openai.UserMessage("대화 기록:\n" + history.String() + "\n마지막 질문: " + latest),
+			},
+			Model: model,
+		})
+		if err != nil || len(resp.Choices) == 0 {
+			slog.Warn("failed to rewrite query", slog.Any("error", err))
+			handler.HandleChat(c.WithContext(WithQuery(ctx, latest)))
+			return
+		}
+
+		query := strings.TrimSpace(resp.Choices[0].Message.Content)
+		if query == "" {
+			query = latest
+		}
+		slog.Info("query rewritten", slog.String("original", latest), slog.String("query", query))
+
+		handler.HandleChat(c.WithContext(WithQuery(ctx, query)))
+	})
+}
+
+func hasPreviousQuestion(thread []chat.Message) bool {
+	if len(thread) < 2 {
+		return false
+	}
+	for _, m := range thread[:len(thread)-1] {
+		if m.Role == chat.RoleUser {
+			return true
+		}
+	}
+	return false
+}
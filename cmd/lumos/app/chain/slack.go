@@ -2,6 +2,7 @@ package chain
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
@@ -29,9 +30,16 @@ func WithSlackClientInit(handler chat.Handler, client *api.Client) chat.HandlerF
 	})
 }
 
+// 어시스턴트 상태를 표시할 수 없는 채널에서 답변을 준비하는 동안 질문에 남기는 반응.
+const thinkingReaction = "eyes"
+
+// AssistantStatusUpdate는 답변을 준비하는 동안 어시스턴트 스레드에 상태를 표시합니다.
+//
+// 채널에서 멘션이나 메시지 단축키로 시작된 대화는 어시스턴트 상태를 사용할 수 없으므로,
+// 대신 질문 메시지에 반응을 남기고 이후 단계가 끝나면 제거합니다.
 func AssistantStatusUpdate(handler chat.Handler, status string) chat.HandlerFunc {
-	return chat.HandlerFunc(func(chat *chat.Chat) {
-		ctx := chat.Context()
+	return chat.HandlerFunc(func(c *chat.Chat) {
+		ctx := c.Context()
 		slackClient := SlackClientFrom(ctx)
 
 		if slackClient == nil {
@@ -39,15 +47,59 @@ func AssistantStatusUpdate(handler chat.Handler, status string) chat.HandlerFunc
 			return
 		}
 
+		// 슬래시 커맨드는 질문 메시지가 없으므로 상태를 표시하지 않는다.
+		if c.Kind == chat.KindSlashCommand {
+			handler.HandleChat(c)
+			return
+		}
+
+		if c.Kind == chat.KindMention || c.Kind == chat.KindMessageAction {
+			if addThinkingReaction(ctx, slackClient, c) {
+				defer removeThinkingReaction(ctx, slackClient, c)
+			}
+			handler.HandleChat(c)
+			return
+		}
+
 		_, err := slackClient.AssistantSetStatus(ctx, &api.AssistantSetStatusRequest{
-			Channel:         chat.Channel,
-			ThreadTimestamp: chat.Timestamp,
+			Channel:         c.Channel,
+			ThreadTimestamp: c.Timestamp,
 			Status:          status,
 		})
 		if err != nil {
 			slog.Warn("failed to set slack status", "error", err)
 		}
 
-		handler.HandleChat(chat)
+		handler.HandleChat(c)
+	})
+}
+
+// addThinkingReaction은 질문 메시지에 반응을 남기고, 새로 남긴 경우 true를 반환합니다.
+// 앞선 단계에서 이미 반응을 남겼다면 제거도 그 단계에서 하도록 false를 반환합니다.
+func addThinkingReaction(ctx context.Context, client *api.Client, c *chat.Chat) bool {
+	_, err := client.ReactionsAdd(ctx, &api.ReactionsAddRequest{
+		Channel:   c.Channel,
+		Name:      thinkingReaction,
+		Timestamp: c.MessageTimestamp,
+	})
+	if err != nil {
+		// 앞선 단계에서 이미 반응을 남긴 경우는 문제가 아니므로 기록하지 않는다.
+		var apiErr *api.APIError
+		if !errors.As(err, &apiErr) || apiErr.Code != "already_reacted" {
+			slog.Warn("failed to add reaction", slog.Any("error", err))
+		}
+		return false
+	}
+	return true
+}
+
+func removeThinkingReaction(ctx context.Context, client *api.Client, c *chat.Chat) {
+	_, err := client.ReactionsRemove(ctx, &api.ReactionsRemoveRequest{
+		Channel:   c.Channel,
+		Name:      thinkingReaction,
+		Timestamp: c.MessageTimestamp,
 	})
+	if err != nil {
+		slog.Warn("failed to remove reaction", slog.Any("error", err))
+	}
 }
@@ -0,0 +1,164 @@
+package chain
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+	"github.com/joyfuldevs/project-lumos/pkg/slack"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/mrkdwn"
+)
+
+const (
+	// 스트리밍 중인 답변 끝에 표시하는 커서.
+	streamingCursor = " ▍"
+	// chat.update 호출이 실패했을 때 늘릴 수 있는 최대 갱신 간격.
+	maxUpdateInterval = 10 * time.Second
+)
+
+type responseMessageKeyType int
+
+const responseMessageKey responseMessageKeyType = iota
+
+// WithResponseMessage는 답변을 표시하기 위해 미리 게시한 메시지의 타임스탬프를 저장합니다.
+func WithResponseMessage(parent context.Context, ts slack.Timestamp) context.Context {
+	return context.WithValue(parent, responseMessageKey, ts)
+}
+
+func ResponseMessageFrom(ctx context.Context) slack.Timestamp {
+	info, _ := ctx.Value(responseMessageKey).(slack.Timestamp)
+	return info
+}
+
+// StreamingResponseGeneration은 답변을 스트리밍으로 생성하면서 Slack 메시지를 점진적으로 갱신합니다.
+//
+// 먼저 자리 표시 메시지를 게시한 뒤, 토큰을 받는 동안 최소 interval 간격으로 chat.update를 호출합니다.
+// 생성 중인 답변도 Markdown을 mrkdwn으로 변환하여 표시합니다.
+// 최종 답변은 ChatResponse가 같은 메시지를 갱신하여 완성합니다.
+// generation이 nil이면 DefaultGeneration을 사용합니다.
+func StreamingResponseGeneration(handler chat.Handler, interval time.Duration, generation *Generation) chat.HandlerFunc {
+	if generation == nil {
+		generation = DefaultGeneration()
+	}
+	return chat.HandlerFunc(func(chat *chat.Chat) {
+		ctx := chat.Context()
+
+		passages := PassagesFrom(ctx)
+		if len(passages) == 0 {
+			chat = chat.WithContext(WithResponse(ctx, "관련된 정보를 찾을 수 없습니다."))
+			handler.HandleChat(chat)
+			return
+		}
+
+		client := ChatClientFrom(ctx)
+		if client == nil {
+			chat = chat.WithContext(WithResponse(ctx, "답변 생성에 필요한 서비스가 준비되지 않았습니다."))
+			handler.HandleChat(chat)
+			return
+		}
+
+		params, err := generation.completionParams(chat, passages)
+		if err != nil {
+			slog.Error("failed to build prompt", slog.Any("error", err))
+			chat = chat.WithContext(WithResponse(ctx, "답변 생성에 실패했습니다."))
+			handler.HandleChat(chat)
+			return
+		}
+
+		updater := newMessageUpdater(ctx, chat, interval)
+
+		stream := client.Chat.Completions.NewStreaming(ctx, params)
+		defer func() {
+			if err := stream.Close(); err != nil {
+				slog.Warn("failed to close completion stream", slog.Any("error", err))
+			}
+		}()
+
+		var text strings.Builder
+		for stream.Next() {
+			chunk := stream.Current()
+			if len(chunk.Choices) > 0 {
+				text.WriteString(chunk.Choices[0].Delta.Content)
+			}
+			updater.update(text.String())
+		}
+
+		ctx = WithResponseMessage(ctx, updater.ts)
+		if err := stream.Err(); err != nil || text.Len() == 0 {
+			slog.Error("failed to generate response", "error", err)
+			chat = chat.WithContext(WithResponse(ctx, "답변 생성에 실패했습니다."))
+			handler.HandleChat(chat)
+			return
+		}
+
+		ctx = WithResponse(ctx, text.String())
+		handler.HandleChat(chat.WithContext(ctx))
+	})
+}
+
+// messageUpdater는 생성 중인 답변으로 자리 표시 메시지를 일정 간격마다 갱신합니다.
+type messageUpdater struct {
+	ctx      context.Context
+	client   *api.Client
+	channel  string
+	ts       slack.Timestamp
+	interval time.Duration
+
+	last     time.Time
+	lastText string
+}
+
+func newMessageUpdater(ctx context.Context, c *chat.Chat, interval time.Duration) *messageUpdater {
+	u := &messageUpdater{
+		ctx:      ctx,
+		client:   SlackClientFrom(ctx),
+		channel:  c.Channel,
+		interval: interval,
+		last:     time.Now(),
+	}
+	// 슬래시 커맨드는 채널에 자리 표시 메시지를 게시하지 않고 최종 답변만 보낸다.
+	if u.client == nil || c.Kind == chat.KindSlashCommand {
+		return u
+	}
+
+	resp, err := u.client.PostMessage(ctx, &api.PostMessageRequest{
+		Channel:         c.Channel,
+		Text:            "답변을 작성하는 중..." + streamingCursor,
+		ThreadTimestamp: c.Timestamp,
+	})
+	if err != nil {
+		slog.Warn("failed to post placeholder message", slog.Any("error", err))
+		return u
+	}
+	u.ts = resp.Timestamp
+
+	return u
+}
+
+func (u *messageUpdater) update(text string) {
+	if u.ts == "" || text == "" || text == u.lastText {
+		return
+	}
+	if time.Since(u.last) < u.interval {
+		return
+	}
+	u.last = time.Now()
+
+	_, err := u.client.UpdateMessage(u.ctx, &api.UpdateMessageRequest{
+		Channel:   u.channel,
+		Timestamp: u.ts,
+		Text:      mrkdwn.Convert(text) + streamingCursor,
+	})
+	if err != nil {
+		// 요청 제한에 걸렸을 가능성이 있으므로 갱신 간격을 늘린다.
+		u.interval = min(u.interval*2, maxUpdateInterval)
+		slog.Warn("failed to update streaming message",
+			slog.Duration("interval", u.interval),
+			slog.Any("error", err))
+		return
+	}
+	u.lastText = text
+}
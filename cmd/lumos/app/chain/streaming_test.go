@@ -0,0 +1,124 @@
+package chain
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
+)
+
+// slackTransport는 Slack API 요청을 기록하고 미리 정한 응답 본문을 반환합니다.
+type slackTransport struct {
+	body     string
+	requests int
+}
+
+func (t *slackTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(t.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestMessageUpdater(t *testing.T) {
+	const interval = time.Minute
+
+	testCases := []struct {
+		desc         string
+		body         string
+		interval     time.Duration
+		elapsed      time.Duration
+		lastText     string
+		text         string
+		wantRequests int
+		wantInterval time.Duration
+		wantLastText string
+	}{
+		{
+			desc:         "updates after the interval",
+			body:         `{"ok":true}`,
+			interval:     interval,
+			elapsed:      interval,
+			lastText:     "답변",
+			text:         "답변을",
+			wantRequests: 1,
+			wantInterval: interval,
+			wantLastText: "답변을",
+		},
+		{
+			desc:         "throttles within the interval",
+			body:         `{"ok":true}`,
+			interval:     interval,
+			elapsed:      interval / 2,
+			lastText:     "답변",
+			text:         "답변을",
+			wantInterval: interval,
+			wantLastText: "답변",
+		},
+		{
+			desc:         "skips unchanged text",
+			body:         `{"ok":true}`,
+			interval:     interval,
+			elapsed:      interval,
+			lastText:     "답변",
+			text:         "답변",
+			wantInterval: interval,
+			wantLastText: "답변",
+		},
+		{
+			desc:         "backs off when the update fails",
+			body:         `{"ok":false,"error":"ratelimited"}`,
+			interval:     time.Second,
+			elapsed:      time.Second,
+			lastText:     "답변",
+			text:         "답변을",
+			wantRequests: 1,
+			wantInterval: 2 * time.Second,
+			wantLastText: "답변",
+		},
+		{
+			desc:         "backoff is capped",
+			body:         `{"ok":false,"error":"ratelimited"}`,
+			interval:     8 * time.Second,
+			elapsed:      8 * time.Second,
+			lastText:     "답변",
+			text:         "답변을",
+			wantRequests: 1,
+			wantInterval: maxUpdateInterval,
+			wantLastText: "답변",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			transport := &slackTransport{body: tc.body}
+			u := &messageUpdater{
+				ctx:      context.Background(),
+				client:   api.NewClient(&http.Client{Transport: transport}, "", ""),
+				channel:  "C1",
+				ts:       "1700000000.000100",
+				interval: tc.interval,
+				last:     time.Now().Add(-tc.elapsed),
+				lastText: tc.lastText,
+			}
+
+			u.update(tc.text)
+
+			if transport.requests != tc.wantRequests {
+				t.Errorf("got %d requests, want %d", transport.requests, tc.wantRequests)
+			}
+			if u.interval != tc.wantInterval {
+				t.Errorf("got interval %v, want %v", u.interval, tc.wantInterval)
+			}
+			if u.lastText != tc.wantLastText {
+				t.Errorf("got last text %q, want %q", u.lastText, tc.wantLastText)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package chain
+
+import (
+	"log/slog"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/eventsapi"
+)
+
+// ThreadHistory는 Slack 스레드의 이전 대화를 불러와 chat.Thread를 채웁니다.
+// 최근 maxTurns 개의 대화 턴만 유지하며, 0 이하인 경우 전체 대화를 유지합니다.
+//
+// 메시지 단축키로 시작된 대화는 선택한 메시지만 질문으로 사용하므로 스레드를 불러오지 않습니다.
+func ThreadHistory(handler chat.Handler, maxTurns int) chat.HandlerFunc {
+	return chat.HandlerFunc(func(c *chat.Chat) {
+		ctx := c.Context()
+		client := SlackClientFrom(ctx)
+		if client == nil || c.Timestamp == "" || c.Kind == chat.KindMessageAction {
+			handler.HandleChat(c)
+			return
+		}
+
+		messages, err := client.ConversationsRepliesAll(ctx, c.Channel, c.Timestamp)
+		if err != nil {
+			slog.Warn("failed to load thread history", slog.Any("error", err))
+			handler.HandleChat(c)
+			return
+		}
+
+		thread := make([]chat.Message, 0, len(messages)+1)
+		for _, m := range messages {
+			if m.Text == "" {
+				continue
+			}
+			if m.BotID != "" {
+				thread = append(thread, chat.Message{Role: chat.RoleAssistant, Text: m.Text})
+				continue
+			}
+			// 채널에서는 봇을 멘션하여 질문하므로 멘션을 제거한다.
+			if text := eventsapi.StripMentions(m.Text); text != "" {
+				thread = append(thread, chat.Message{Role: chat.RoleUser, Text: text})
+			}
+		}
+
+		// 이벤트를 받은 시점에 메시지가 아직 조회되지 않을 수 있으므로 마지막 질문을 보장한다.
+		latest := c.Latest()
+		if latest.Text != "" && (len(thread) == 0 || thread[len(thread)-1] != latest) {
+			thread = append(thread, latest)
+		}
+
+		if maxTurns > 0 && len(thread) > maxTurns {
+			thread = thread[len(thread)-maxTurns:]
+		}
+
+		c = c.WithContext(ctx)
+		c.Thread = thread
+		handler.HandleChat(c)
+	})
+}
@@ -0,0 +1,151 @@
+package chain_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chain"
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
+)
+
+// repliesTransport는 conversations.replies 요청에 messages를 한 페이지로 응답합니다.
+// messages가 nil이면 오류를 응답합니다.
+type repliesTransport struct {
+	messages []api.Message
+	requests int
+}
+
+func (t *repliesTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	body := `{"ok":false,"error":"thread_not_found"}`
+	if t.messages != nil {
+		data, err := json.Marshal(map[string]any{"ok": true, "messages": t.messages})
+		if err != nil {
+			return nil, err
+		}
+		body = string(data)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestThreadHistory(t *testing.T) {
+	// long은 사용자와 봇이 번갈아 남긴 n개의 메시지를 만듭니다.
+	long := func(n int) []api.Message {
+		messages := make([]api.Message, 0, n)
+		for i := range n {
+			if i%2 == 0 {
+				messages = append(messages, api.Message{User: "U1", Text: fmt.Sprintf("질문 %d", i)})
+			} else {
+				messages = append(messages, api.Message{BotID: "B1", Text: fmt.Sprintf("답변 %d", i)})
+			}
+		}
+		return messages
+	}
+	question := chat.Message{Role: chat.RoleUser, Text: "질문 24"}
+
+	testCases := []struct {
+		desc         string
+		kind         chat.Kind
+		messages     []api.Message
+		want         []chat.Message
+		wantRequests int
+	}{
+		{
+			desc: "bot and user messages",
+			kind: chat.KindMention,
+			messages: []api.Message{
+				{User: "U1", Text: "<@U0BOT> 결제가 실패해요"},
+				{BotID: "B1", Text: "원인을 찾아볼게요"},
+				{User: "U1", Text: ""},
+				{User: "U1", Text: "<@U0BOT>"},
+				{User: "U1", Text: "질문 24"},
+			},
+			want: []chat.Message{
+				{Role: chat.RoleUser, Text: "결제가 실패해요"},
+				{Role: chat.RoleAssistant, Text: "원인을 찾아볼게요"},
+				{Role: chat.RoleUser, Text: "질문 24"},
+			},
+			wantRequests: 1,
+		},
+		{
+			desc:     "keeps the latest 20 turns",
+			kind:     chat.KindMention,
+			messages: long(25),
+			want: func() []chat.Message {
+				var thread []chat.Message
+				for i := 5; i < 25; i++ {
+					role, text := chat.RoleUser, fmt.Sprintf("질문 %d", i)
+					if i%2 == 1 {
+						role, text = chat.RoleAssistant, fmt.Sprintf("답변 %d", i)
+					}
+					thread = append(thread, chat.Message{Role: role, Text: text})
+				}
+				return thread
+			}(),
+			wantRequests: 1,
+		},
+		{
+			desc: "latest question not loaded yet",
+			kind: chat.KindMention,
+			messages: []api.Message{
+				{User: "U1", Text: "결제가 실패해요"},
+				{BotID: "B1", Text: "원인을 찾아볼게요"},
+			},
+			want: []chat.Message{
+				{Role: chat.RoleUser, Text: "결제가 실패해요"},
+				{Role: chat.RoleAssistant, Text: "원인을 찾아볼게요"},
+				question,
+			},
+			wantRequests: 1,
+		},
+		{
+			desc:         "load fails",
+			kind:         chat.KindMention,
+			want:         []chat.Message{question},
+			wantRequests: 1,
+		},
+		{
+			desc:     "message action",
+			kind:     chat.KindMessageAction,
+			messages: long(3),
+			want:     []chat.Message{question},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			transport := &repliesTransport{messages: tc.messages}
+			client := api.NewClient(&http.Client{Transport: transport}, "", "")
+
+			var got []chat.Message
+			handler := chain.ThreadHistory(chat.HandlerFunc(func(c *chat.Chat) {
+				got = c.Thread
+			}), 20)
+
+			c := &chat.Chat{
+				Kind:      tc.kind,
+				Channel:   "C1",
+				Timestamp: "1.0",
+				Thread:    []chat.Message{question},
+			}
+			handler.HandleChat(c.WithContext(chain.WithSlackClient(context.Background(), client)))
+
+			if transport.requests != tc.wantRequests {
+				t.Errorf("got %d requests, want %d", transport.requests, tc.wantRequests)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -4,19 +4,67 @@ import (
 	"context"
 
 	"github.com/joyfuldevs/project-lumos/pkg/slack"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
 )
 
+type Role string
+
+const (
+	// 사용자가 보낸 메시지.
+	RoleUser Role = "user"
+	// 봇이 보낸 메시지.
+	RoleAssistant Role = "assistant"
+)
+
+// Kind는 대화가 시작된 곳을 나타냅니다.
+type Kind string
+
+const (
+	// 어시스턴트 창이나 DM에서 시작된 대화.
+	KindAssistant Kind = "assistant"
+	// 채널에서 앱을 멘션하여 시작된 대화.
+	KindMention Kind = "mention"
+	// 메시지 단축키로 시작된 대화. 선택한 메시지를 질문으로 사용하고 그 메시지의 스레드에 답변합니다.
+	KindMessageAction Kind = "message_action"
+	// 슬래시 커맨드로 시작된 대화. 스레드 없이 response_url로 답변합니다.
+	KindSlashCommand Kind = "slash_command"
+)
+
+// Message는 스레드에 포함된 하나의 대화 턴입니다.
+type Message struct {
+	// 메시지를 보낸 주체.
+	Role Role
+	// 메시지 내용.
+	Text string
+}
+
 type Chat struct {
+	// 대화가 시작된 곳.
+	Kind Kind
 	// 대화가 이루어진 채널 ID.
 	Channel string
+	// 질문한 사용자 ID.
+	User string
 	// 스레드의 타임스탬프.
 	Timestamp slack.Timestamp
-	// 스레드 내용.
-	Thread []string
+	// 질문 메시지의 타임스탬프.
+	MessageTimestamp slack.Timestamp
+	// 스레드 내용. 오래된 메시지부터 순서대로 저장됩니다.
+	Thread []Message
+	// 답변을 보낼 response_url. 슬래시 커맨드로 시작된 대화에서만 사용합니다.
+	ResponseURL *api.ResponseURL
 
 	ctx context.Context
 }
 
+// Latest는 스레드의 가장 최근 메시지를 반환합니다.
+func (c *Chat) Latest() Message {
+	if len(c.Thread) == 0 {
+		return Message{}
+	}
+	return c.Thread[len(c.Thread)-1]
+}
+
 func (c *Chat) Context() context.Context {
 	return c.ctx
 }
@@ -0,0 +1,349 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chain"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+)
+
+// Config는 lumos 봇의 설정입니다.
+type Config struct {
+	// Jira 서버 주소. 답변에 참고한 이슈의 링크를 만들 때 사용합니다.
+	// JIRA_SERVER 환경 변수로 설정합니다.
+	JiraServer string `yaml:"-"`
+
+	// 채널 ID별로 우선 검색할 Jira 프로젝트 키 목록.
+	// e.g., 결제 개발 채널에서 질문하면 PAY 프로젝트의 이슈를 우선합니다.
+	ChannelProjects map[string][]string `yaml:"channel_projects"`
+	// 우선 검색할 프로젝트의 이슈 점수를 높이는 배율. chain.ProjectPreference를 참고하세요.
+	ProjectBoost float64 `yaml:"project_boost"`
+
+	// 어시스턴트 스레드 설정.
+	Assistant AssistantConfig `yaml:"assistant"`
+	// 이벤트 처리 설정.
+	Bot BotConfig `yaml:"bot"`
+	// 답변 생성 설정.
+	Generation GenerationConfig `yaml:"generation"`
+	// 패시지 검색 서비스 목록. 비어있으면 dense, sparse 검색 서비스를 사용합니다.
+	Retrieval []RetrievalConfig `yaml:"retrieval"`
+	// 검색 결과 결합 설정.
+	Fusion FusionConfig `yaml:"fusion"`
+	// 이슈 검색 서비스 설정.
+	IssueRetrieval IssueRetrievalConfig `yaml:"issue_retrieval"`
+	// 피드백 서비스 설정.
+	Feedback FeedbackConfig `yaml:"feedback"`
+	// 슬래시 커맨드 설정.
+	SlashCommand SlashCommandConfig `yaml:"slash_command"`
+}
+
+// SlashCommandConfig는 슬래시 커맨드로 질문했을 때의 동작을 설정합니다.
+type SlashCommandConfig struct {
+	// 질문을 받을 커맨드 이름. 비어있으면 /lumos를 사용합니다.
+	Command string `yaml:"command"`
+	// 답변을 채널에 공개할지(in_channel), 질문한 사용자에게만 보여줄지(ephemeral) 지정합니다.
+	// 비어있으면 ephemeral을 사용합니다.
+	ResponseType interactive.ResponseType `yaml:"response_type"`
+	// 상세 검색에서 선택할 수 있는 이슈 상태 목록. 비어있으면 To Do, In Progress, Done을 사용합니다.
+	SearchStatuses []SearchStatus `yaml:"search_statuses"`
+}
+
+// SearchStatus는 상세 검색에서 선택할 수 있는 이슈 상태입니다.
+type SearchStatus struct {
+	// Jira 이슈 상태 이름. e.g., In Progress
+	Name string `yaml:"name"`
+	// 모달에 표시할 이름. 비어있으면 Name을 표시합니다.
+	Label string `yaml:"label"`
+}
+
+// IssueRetrievalConfig는 검색된 패시지의 이슈 전체 내용을 조회하는 이슈 검색 서비스를 설정합니다.
+type IssueRetrievalConfig struct {
+	// 이슈 검색 서비스를 사용하지 않을지 여부.
+	Disabled bool `yaml:"disabled"`
+	// 이슈 검색 서비스 주소. 비어있으면 issue-retrieval-service를 사용합니다.
+	Host string `yaml:"host"`
+	// 이슈 검색 서비스 포트. 비어있으면 50051을 사용합니다.
+	Port string `yaml:"port"`
+	// 조회 요청의 제한 시간. 0이면 3초를 사용합니다.
+	Timeout time.Duration `yaml:"timeout"`
+	// 전체 내용을 조회할 상위 패시지 수. 0이면 3개를 조회합니다.
+	TopK int `yaml:"top_k"`
+	// 질문에 언급되면 직접 조회할 이슈의 프로젝트 키 목록. 비어있으면 모든 프로젝트의 이슈를 조회합니다.
+	LookupProjects []string `yaml:"lookup_projects"`
+}
+
+// FeedbackConfig는 답변에 대한 사용자 피드백을 저장하는 피드백 서비스를 설정합니다.
+type FeedbackConfig struct {
+	// 피드백 서비스 주소. 비어있으면 feedback-service를 사용합니다.
+	Host string `yaml:"host"`
+	// 피드백 서비스 포트. 비어있으면 50051을 사용합니다.
+	Port string `yaml:"port"`
+}
+
+// RetrievalConfig는 하나의 패시지 검색 서비스를 설정합니다.
+type RetrievalConfig struct {
+	// 검색 서비스 이름. 가중치를 적용하거나 로그를 남길 때 사용합니다.
+	Name string `yaml:"name"`
+	// 검색 서비스 주소.
+	Host string `yaml:"host"`
+	// 검색 서비스 포트. 비어있으면 50051을 사용합니다.
+	Port string `yaml:"port"`
+	// 검색 요청의 제한 시간. 0이면 10초를 사용합니다.
+	Timeout time.Duration `yaml:"timeout"`
+	// 검색할 최대 패시지 수. 0이면 10개를 검색합니다.
+	Limit int32 `yaml:"limit"`
+	// 검색 결과를 결합할 때 사용하는 가중치. 0이면 1을 사용합니다.
+	Weight float64 `yaml:"weight"`
+}
+
+// RetrievalWeights는 검색 서비스 이름별 가중치를 반환합니다.
+func (c *Config) RetrievalWeights() map[string]float64 {
+	weights := make(map[string]float64, len(c.Retrieval))
+	for _, r := range c.Retrieval {
+		weights[r.Name] = r.Weight
+	}
+	return weights
+}
+
+// 검색 결과를 결합하는 전략.
+const (
+	// 검색 서비스별 순위로 점수를 결합합니다. chain.ReciprocalRankFusion을 참고하세요.
+	FusionRRF = "rrf"
+	// 검색 서비스별로 정규화한 점수의 가중합으로 결합합니다. chain.WeightedScoreFusion을 참고하세요.
+	FusionWeighted = "weighted"
+)
+
+// FusionConfig는 여러 검색 서비스의 결과를 결합하는 방식을 설정합니다.
+type FusionConfig struct {
+	// 결합 전략. rrf 또는 weighted를 지정합니다. 비어있으면 rrf를 사용합니다.
+	Strategy string `yaml:"strategy"`
+	// 결합한 뒤 유지할 상위 패시지 수. 0이면 10개를 유지합니다.
+	TopN int `yaml:"top_n"`
+}
+
+// PassageFusion은 설정한 전략으로 검색 서비스별 가중치를 적용하는 결합 전략을 생성합니다.
+func (c *Config) PassageFusion() chain.Fusion {
+	weights := c.RetrievalWeights()
+	if c.Fusion.Strategy == FusionWeighted {
+		return &chain.WeightedScoreFusion{Weights: weights}
+	}
+	return &chain.ReciprocalRankFusion{Weights: weights}
+}
+
+// GenerationConfig는 답변 생성에 사용할 모델과 프롬프트를 설정합니다.
+// 프롬프트는 Go text/template 형식이며 chain.PromptData의 값을 사용할 수 있습니다.
+type GenerationConfig struct {
+	// 답변 생성에 사용할 모델. 비어있으면 기본 모델을 사용합니다.
+	Model string `yaml:"model"`
+	// 후속 질문을 검색 질의로 재작성할 때 사용할 모델. 비어있으면 답변 생성 모델을 사용합니다.
+	RewriteModel string `yaml:"rewrite_model"`
+	// 샘플링 온도. 지정하지 않으면 모델의 기본값을 사용합니다.
+	Temperature *float64 `yaml:"temperature"`
+	// 생성할 최대 토큰 수. 0이면 제한하지 않습니다.
+	MaxTokens int64 `yaml:"max_tokens"`
+	// 추론 모델의 추론 강도. e.g., low, medium, high
+	ReasoningEffort string `yaml:"reasoning_effort"`
+	// 패시지를 하나의 시스템 메시지로 합칠지 여부.
+	CombinePassages bool `yaml:"combine_passages"`
+	// 시스템 메시지 템플릿.
+	SystemPrompt string `yaml:"system_prompt"`
+	// 마지막 사용자 메시지 템플릿.
+	UserPrompt string `yaml:"user_prompt"`
+	// 답변 생성에 참고할 패시지에 사용할 수 있는 최대 토큰 수.
+	ContextBudget int `yaml:"context_budget"`
+}
+
+// Generation은 설정한 프롬프트 템플릿을 파싱하여 답변 생성 설정을 만듭니다.
+func (c *GenerationConfig) Generation() (*chain.Generation, error) {
+	g := chain.DefaultGeneration()
+	if c.Model != "" {
+		g.Model = c.Model
+	}
+	g.Temperature = c.Temperature
+	g.MaxTokens = c.MaxTokens
+	g.ReasoningEffort = c.ReasoningEffort
+	g.CombinePassages = c.CombinePassages
+
+	system := c.SystemPrompt
+	if system == "" && c.CombinePassages {
+		system = chain.DefaultCombinedSystemTemplate
+	}
+	if system != "" {
+		tmpl, err := chain.ParsePromptTemplate("system", system)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse system prompt: %w", err)
+		}
+		g.System = tmpl
+	}
+	if c.UserPrompt != "" {
+		tmpl, err := chain.ParsePromptTemplate("user", c.UserPrompt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse user prompt: %w", err)
+		}
+		g.User = tmpl
+	}
+
+	return g, nil
+}
+
+// BotConfig는 Slack 이벤트 처리 방식을 설정합니다.
+type BotConfig struct {
+	// 이벤트를 동시에 처리하는 워커 수. 0이면 기본값을 사용합니다.
+	Workers int `yaml:"workers"`
+	// 워커별로 대기할 수 있는 이벤트 수. 전체 대기열의 크기는 Workers × QueueSize입니다.
+	// 0이면 기본값을 사용합니다.
+	QueueSize int `yaml:"queue_size"`
+	// Slack이 연결을 갱신할 때 기존 연결이 닫히기 전에 새 연결을 미리 열어 둘지 여부.
+	WarmStandby bool `yaml:"warm_standby"`
+}
+
+// AssistantConfig는 어시스턴트 스레드가 시작될 때 보여줄 내용을 설정합니다.
+type AssistantConfig struct {
+	// 스레드가 시작될 때 게시하는 인사말.
+	Greeting string `yaml:"greeting"`
+	// 추천 질문 목록 위에 표시하는 제목.
+	PromptsTitle string `yaml:"prompts_title"`
+	// 기본 추천 질문 목록. Slack은 최대 4개까지 표시합니다.
+	Prompts []api.SuggestedPrompt `yaml:"prompts"`
+	// Jira 프로젝트 키별 추천 질문 목록.
+	// 사용자가 보고 있는 채널에 연결된 프로젝트의 추천 질문이 기본 추천 질문보다 우선합니다.
+	ProjectPrompts map[string][]api.SuggestedPrompt `yaml:"project_prompts"`
+}
+
+// SuggestedPrompts는 사용자가 보고 있는 채널에 맞는 추천 질문 목록을 반환합니다.
+func (c *Config) SuggestedPrompts(contextChannelID string) []api.SuggestedPrompt {
+	for _, project := range c.ChannelProjects[contextChannelID] {
+		if prompts, ok := c.Assistant.ProjectPrompts[project]; ok {
+			return prompts
+		}
+	}
+	return c.Assistant.Prompts
+}
+
+// Projects는 설정에 등장하는 Jira 프로젝트 키 목록을 정렬하여 반환합니다.
+func (c *Config) Projects() []string {
+	var projects []string
+	for _, keys := range c.ChannelProjects {
+		projects = append(projects, keys...)
+	}
+	projects = append(projects, c.IssueRetrieval.LookupProjects...)
+	slices.Sort(projects)
+	return slices.Compact(projects)
+}
+
+// LoadConfig는 YAML 파일에서 설정을 읽어옵니다.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	config := &Config{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	config.setDefaults()
+
+	switch config.Fusion.Strategy {
+	case FusionRRF, FusionWeighted:
+	default:
+		return nil, fmt.Errorf("unknown fusion strategy: %q", config.Fusion.Strategy)
+	}
+
+	return config, nil
+}
+
+func (c *Config) setDefaults() {
+	if c.ProjectBoost <= 0 {
+		c.ProjectBoost = 1.5
+	}
+	if len(c.Retrieval) == 0 {
+		c.Retrieval = []RetrievalConfig{
+			{Name: "dense", Host: "dense-retrieval-service"},
+			{Name: "sparse", Host: "sparse-retrieval-service"},
+		}
+	}
+	for i := range c.Retrieval {
+		r := &c.Retrieval[i]
+		if r.Port == "" {
+			r.Port = "50051"
+		}
+		if r.Timeout <= 0 {
+			r.Timeout = 10 * time.Second
+		}
+		if r.Limit <= 0 {
+			r.Limit = 10
+		}
+		if r.Weight <= 0 {
+			r.Weight = 1
+		}
+	}
+	if c.Fusion.Strategy == "" {
+		c.Fusion.Strategy = FusionRRF
+	}
+	if c.Fusion.TopN <= 0 {
+		c.Fusion.TopN = 10
+	}
+	if c.IssueRetrieval.Host == "" {
+		c.IssueRetrieval.Host = "issue-retrieval-service"
+	}
+	if c.IssueRetrieval.Port == "" {
+		c.IssueRetrieval.Port = "50051"
+	}
+	if c.IssueRetrieval.Timeout <= 0 {
+		c.IssueRetrieval.Timeout = 3 * time.Second
+	}
+	if c.IssueRetrieval.TopK <= 0 {
+		c.IssueRetrieval.TopK = 3
+	}
+	if c.Feedback.Host == "" {
+		c.Feedback.Host = "feedback-service"
+	}
+	if c.Feedback.Port == "" {
+		c.Feedback.Port = "50051"
+	}
+	if c.Generation.ContextBudget <= 0 {
+		c.Generation.ContextBudget = 8000
+	}
+	if c.SlashCommand.Command == "" {
+		c.SlashCommand.Command = "/lumos"
+	}
+	if c.SlashCommand.ResponseType == "" {
+		c.SlashCommand.ResponseType = interactive.Ephemeral
+	}
+	if len(c.SlashCommand.SearchStatuses) == 0 {
+		c.SlashCommand.SearchStatuses = []SearchStatus{
+			{Name: "To Do", Label: "해야 할 일"},
+			{Name: "In Progress", Label: "진행 중"},
+			{Name: "Done", Label: "완료"},
+		}
+	}
+	if c.Assistant.Greeting == "" {
+		c.Assistant.Greeting = "안녕하세요! 무엇을 도와드릴까요?"
+	}
+}
+
+// configFromEnv는 LUMOS_CONFIG 환경 변수에 지정된 설정 파일과 환경 변수로 설정을 구성합니다.
+// 설정 파일이 지정되지 않은 경우 기본 설정을 사용합니다.
+func configFromEnv() (*Config, error) {
+	config := &Config{}
+	if path, ok := os.LookupEnv("LUMOS_CONFIG"); ok {
+		c, err := LoadConfig(path)
+		if err != nil {
+			return nil, err
+		}
+		config = c
+	} else {
+		config.setDefaults()
+	}
+
+	config.JiraServer = os.Getenv("JIRA_SERVER")
+
+	return config, nil
+}
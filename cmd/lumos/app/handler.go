@@ -1,29 +1,63 @@
 package app
 
 import (
+	"cmp"
 	"context"
 	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/openai/openai-go"
 
 	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chain"
 	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/thread"
+	"github.com/joyfuldevs/project-lumos/gen/go/feedback/v1"
+	feedbackclient "github.com/joyfuldevs/project-lumos/pkg/service/feedback/client"
+	"github.com/joyfuldevs/project-lumos/pkg/slack"
 	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/bot"
 	"github.com/joyfuldevs/project-lumos/pkg/slack/eventsapi"
 	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/slashcommand"
+)
+
+// AskLumosCallbackID는 메시지 단축키 "Lumos에게 물어보기"의 콜백 ID입니다.
+// Slack 앱 설정에서 메시지 단축키를 만들 때 같은 콜백 ID를 지정해야 합니다.
+const AskLumosCallbackID = "ask_lumos"
+
+var (
+	_ bot.EventHandler            = (*BotHandler)(nil)
+	_ bot.BusyHandler             = (*BotHandler)(nil)
+	_ bot.ViewSubmissionValidator = (*BotHandler)(nil)
 )
 
 type BotHandler struct {
-	slackClient *api.Client
-	chatHandler chat.Handler
+	config         *Config
+	slackClient    *api.Client
+	feedbackClient *feedbackclient.Client
+	threadStore    *thread.Store
+	chatHandler    chat.Handler
 }
 
-func NewBotHandler(slackClient *api.Client, openaiClient *openai.Client) *BotHandler {
-	handler := BuildChatHandlerChain(slackClient, openaiClient)
+func NewBotHandler(
+	config *Config,
+	generation *chain.Generation,
+	retrievers []chain.Retriever,
+	issueClient chain.IssueClient,
+	slackClient *api.Client,
+	openaiClient *openai.Client,
+	feedbackClient *feedbackclient.Client,
+) *BotHandler {
+	threadStore := thread.NewStore(24 * time.Hour)
+	handler := BuildChatHandlerChain(config, generation, retrievers, issueClient, slackClient, openaiClient, threadStore)
 
 	return &BotHandler{
-		slackClient: slackClient,
-		chatHandler: handler,
+		config:         config,
+		slackClient:    slackClient,
+		feedbackClient: feedbackClient,
+		threadStore:    threadStore,
+		chatHandler:    handler,
 	}
 }
 
@@ -35,27 +69,55 @@ func (b *BotHandler) HandleEventsAPI(ctx context.Context, payload *eventsapi.Pay
 	e := payload.OfEventCallback.Event
 	switch e.Type {
 	case eventsapi.EventTypeAssistantThreadStarted:
-		_, err := b.slackClient.PostMessage(ctx, &api.PostMessageRequest{
-			Channel:         e.OfAssistantThreadStarted.AssistantThread.ChannelID,
-			Text:            "안녕하세요! 무엇을 도와드릴까요?",
-			ThreadTimestamp: e.OfAssistantThreadStarted.AssistantThread.ThreadTimestamp,
-		})
-		if err != nil {
-			slog.Error("failed to post message", slog.Any("error", err))
-		}
+		at := e.OfAssistantThreadStarted.AssistantThread
+		b.threadStore.SetContextChannel(at.ChannelID, at.ThreadTimestamp, at.Context.ChannelID)
+		b.startAssistantThread(ctx, &at)
 
 	case eventsapi.EventTypeAssistantThreadContextChanged:
-		// TODO: Implement thread context changed handling
+		at := e.OfAssistantThreadContextChanged.AssistantThread
+		b.threadStore.SetContextChannel(at.ChannelID, at.ThreadTimestamp, at.Context.ChannelID)
 
 	case eventsapi.EventTypeMessage:
 		// 봇이 보낸 메시지는 무시한다.
 		if e.OfMessage.BotID != "" || e.OfMessage.User == "" {
 			return
 		}
+		// 채널 메시지는 멘션된 경우에만 app_mention 이벤트로 처리한다.
+		if e.OfMessage.ChannelType != "" && e.OfMessage.ChannelType != "im" {
+			return
+		}
+		c := &chat.Chat{
+			Kind:             chat.KindAssistant,
+			Channel:          e.OfMessage.Channel,
+			User:             e.OfMessage.User,
+			Timestamp:        e.OfMessage.ThreadTimestamp,
+			MessageTimestamp: e.OfMessage.MessageTimestamp,
+			Thread: []chat.Message{
+				{Role: chat.RoleUser, Text: e.OfMessage.Text},
+			},
+		}
+		c = c.WithContext(ctx)
+		b.chatHandler.HandleChat(c)
+
+	case eventsapi.EventTypeAppMention:
+		m := e.OfAppMention
+		if m.BotID != "" || m.User == "" {
+			return
+		}
+		// 스레드 밖에서 멘션하면 멘션한 메시지 아래에 스레드로 답변한다.
+		threadTimestamp := m.ThreadTimestamp
+		if threadTimestamp == "" {
+			threadTimestamp = m.MessageTimestamp
+		}
 		c := &chat.Chat{
-			Channel:   e.OfMessage.Channel,
-			Timestamp: e.OfMessage.ThreadTimestamp,
-			Thread:    []string{e.OfMessage.Text},
+			Kind:             chat.KindMention,
+			Channel:          m.Channel,
+			User:             m.User,
+			Timestamp:        threadTimestamp,
+			MessageTimestamp: m.MessageTimestamp,
+			Thread: []chat.Message{
+				{Role: chat.RoleUser, Text: eventsapi.StripMentions(m.Text)},
+			},
 		}
 		c = c.WithContext(ctx)
 		b.chatHandler.HandleChat(c)
@@ -65,29 +127,294 @@ func (b *BotHandler) HandleEventsAPI(ctx context.Context, payload *eventsapi.Pay
 	}
 }
 
+// HandleBusy는 처리 대기열이 가득 찼을 때 사용자에게 잠시 후 다시 질문해달라고 응답합니다.
+func (b *BotHandler) HandleBusy(ctx context.Context, payload *eventsapi.Payload) {
+	if payload.Type != eventsapi.PayloadTypeEventCallback {
+		return
+	}
+
+	var (
+		channel         string
+		threadTimestamp slack.Timestamp
+	)
+	e := payload.OfEventCallback.Event
+	switch e.Type {
+	case eventsapi.EventTypeMessage:
+		if e.OfMessage.BotID != "" || e.OfMessage.User == "" {
+			return
+		}
+		channel, threadTimestamp = e.OfMessage.Channel, e.OfMessage.ThreadTimestamp
+	case eventsapi.EventTypeAppMention:
+		if e.OfAppMention.BotID != "" || e.OfAppMention.User == "" {
+			return
+		}
+		channel, threadTimestamp = e.OfAppMention.Channel, e.OfAppMention.ThreadTimestamp
+		if threadTimestamp == "" {
+			threadTimestamp = e.OfAppMention.MessageTimestamp
+		}
+	default:
+		return
+	}
+
+	_, err := b.slackClient.PostMessage(ctx, &api.PostMessageRequest{
+		Channel:         channel,
+		Text:            "지금은 질문이 많아 답변을 드리기 어려워요. 잠시 후 다시 질문해주세요.",
+		ThreadTimestamp: threadTimestamp,
+	})
+	if err != nil {
+		slog.Error("failed to post busy message", slog.Any("error", err))
+	}
+}
+
+// startAssistantThread는 어시스턴트 스레드에 인사말을 게시하고 추천 질문을 설정합니다.
+func (b *BotHandler) startAssistantThread(ctx context.Context, at *eventsapi.AssistantThread) {
+	_, err := b.slackClient.PostMessage(ctx, &api.PostMessageRequest{
+		Channel:         at.ChannelID,
+		Text:            b.config.Assistant.Greeting,
+		ThreadTimestamp: at.ThreadTimestamp,
+	})
+	if err != nil {
+		slog.Error("failed to post message", slog.Any("error", err))
+	}
+
+	prompts := b.config.SuggestedPrompts(at.Context.ChannelID)
+	if len(prompts) == 0 {
+		return
+	}
+
+	_, err = b.slackClient.AssistantSetSuggestedPrompts(ctx, &api.AssistantSetSuggestedPromptsRequest{
+		Channel:         at.ChannelID,
+		ThreadTimestamp: at.ThreadTimestamp,
+		Title:           b.config.Assistant.PromptsTitle,
+		Prompts:         prompts[:min(len(prompts), 4)],
+	})
+	if err != nil {
+		slog.Warn("failed to set suggested prompts", slog.Any("error", err))
+	}
+}
+
 func (b *BotHandler) HandleInteractive(ctx context.Context, payload *interactive.Payload) {
-	// TODO: implement interactive handler
 	switch payload.Type {
 	case interactive.PayloadTypeBlockActions:
+		b.handleBlockActions(ctx, payload.OfBlockActions)
 	case interactive.PayloadTypeMessageActions:
+		b.handleMessageAction(ctx, payload.OfMessageActions)
 	case interactive.PayloadTypeViewClosed:
 	case interactive.PayloadTypeViewSubmission:
+		b.handleViewSubmission(ctx, payload.OfViewSubmission)
 	default:
 		slog.Warn("unknown interactive payload", slog.String("type", string(payload.Type)))
 	}
 }
 
-func BuildChatHandlerChain(slackClient *api.Client, openaiClient *openai.Client) chat.Handler {
-	handler := chain.ChatResponse()
+func (b *BotHandler) handleBlockActions(ctx context.Context, payload *interactive.BlockActionsPayload) {
+	for _, action := range payload.Actions {
+		switch action.ActionID {
+		case chain.FeedbackGoodActionID:
+			b.submitFeedback(ctx, payload, action, feedback.FeedbackType_FEEDBACK_TYPE_GOOD)
+		case chain.FeedbackBadActionID:
+			b.submitFeedback(ctx, payload, action, feedback.FeedbackType_FEEDBACK_TYPE_BAD)
+		default:
+			slog.Warn("unknown block action", slog.String("action_id", action.ActionID))
+		}
+	}
+}
+
+// handleMessageAction은 메시지 단축키로 선택한 메시지를 질문으로 삼아 그 메시지의 스레드에 답변합니다.
+// e.g., 채널에 공유된 에러 로그와 관련된 Jira 이슈를 찾아 스레드에 알려줍니다.
+func (b *BotHandler) handleMessageAction(ctx context.Context, payload *interactive.MessageActionsPayload) {
+	if payload.CallbackID != AskLumosCallbackID {
+		slog.Warn("unknown message action", slog.String("callback_id", payload.CallbackID))
+		return
+	}
+	if payload.Channel == nil || payload.Message == nil {
+		slog.Warn("failed to find message of message action")
+		return
+	}
+
+	m := payload.Message
+	question := eventsapi.StripMentions(m.Text)
+	if question == "" {
+		b.respond(ctx, b.slackClient.NewResponseURL(payload.ResponseURL, receivedAt(ctx)), "내용이 있는 메시지에서만 물어볼 수 있어요.")
+		return
+	}
+
+	// 스레드 안의 메시지를 선택하면 같은 스레드에 답변한다.
+	threadTimestamp := m.ThreadTimestamp
+	if threadTimestamp == "" {
+		threadTimestamp = m.Timestamp
+	}
+
+	var user string
+	if payload.User != nil {
+		user = payload.User.ID
+	}
+
+	c := &chat.Chat{
+		Kind:             chat.KindMessageAction,
+		Channel:          payload.Channel.ID,
+		User:             user,
+		Timestamp:        threadTimestamp,
+		MessageTimestamp: m.Timestamp,
+		Thread: []chat.Message{
+			{Role: chat.RoleUser, Text: question},
+		},
+	}
+	c = c.WithContext(ctx)
+	b.chatHandler.HandleChat(c)
+}
+
+func (b *BotHandler) submitFeedback(
+	ctx context.Context,
+	payload *interactive.BlockActionsPayload,
+	action interactive.Action,
+	feedbackType feedback.FeedbackType,
+) {
+	var channel string
+	switch {
+	case payload.Channel != nil:
+		channel = payload.Channel.ID
+	case payload.Container != nil:
+		channel = payload.Container.ChannelID
+	}
+
+	threadTimestamp := slack.Timestamp(action.Value)
+	if threadTimestamp == "" && payload.Message != nil {
+		threadTimestamp = payload.Message.ThreadTimestamp
+	}
+
+	if channel == "" || threadTimestamp == "" {
+		slog.Warn("failed to find feedback thread", slog.String("action_id", action.ActionID))
+		return
+	}
+
+	messages, err := b.slackClient.ConversationsRepliesAll(ctx, channel, threadTimestamp)
+	if err != nil {
+		slog.Error("failed to load feedback thread", slog.Any("error", err))
+		return
+	}
+
+	thread := make([]string, 0, len(messages))
+	for _, m := range messages {
+		if m.Text != "" {
+			thread = append(thread, m.Text)
+		}
+	}
+
+	if err := b.feedbackClient.SubmitFeedbackV1(ctx, feedbackType, thread); err != nil {
+		slog.Error("failed to submit feedback", slog.Any("error", err))
+		return
+	}
+
+	slog.Info("feedback submitted",
+		slog.String("type", feedbackType.String()),
+		slog.String("channel", channel),
+		slog.String("thread_ts", string(threadTimestamp)))
+
+	b.acknowledgeFeedback(ctx, payload)
+}
+
+// acknowledgeFeedback은 피드백을 받은 메시지의 피드백 버튼을 감사 인사로 바꾸어
+// 같은 답변에 피드백을 다시 보내지 않도록 합니다.
+func (b *BotHandler) acknowledgeFeedback(ctx context.Context, payload *interactive.BlockActionsPayload) {
+	if payload.Message == nil {
+		return
+	}
+	blocks, ok := chain.FeedbackReceivedBlocks(payload.Message.Blocks)
+	if !ok {
+		return
+	}
+
+	responseURL := b.slackClient.NewResponseURL(payload.ResponseURL, receivedAt(ctx))
+	if err := responseURL.Replace(ctx, payload.Message.Text, blocks); err != nil {
+		slog.Warn("failed to replace feedback buttons", slog.Any("error", err))
+	}
+}
+
+// HandleSlashCommand는 슬래시 커맨드로 받은 질문에 response_url로 답변합니다.
+// 질문 없이 실행하면 상세 검색 모달을 엽니다.
+func (b *BotHandler) HandleSlashCommand(ctx context.Context, payload *slashcommand.Payload) {
+	if payload.Command != b.config.SlashCommand.Command {
+		slog.Warn("unknown slash command", slog.String("command", payload.Command))
+		return
+	}
+	responseURL := b.slackClient.NewResponseURL(payload.ResponseURL, receivedAt(ctx))
+
+	// 질문 없이 실행하면 검색 조건을 지정할 수 있는 상세 검색 모달을 연다.
+	question := strings.TrimSpace(payload.Text)
+	if question == "" {
+		if err := b.openAdvancedSearch(ctx, payload, responseURL); err != nil {
+			slog.Error("failed to open advanced search", slog.Any("error", err))
+			b.respond(ctx, responseURL, "질문을 함께 입력해주세요. e.g., `"+payload.Command+" 결제 승인 실패 원인`")
+		}
+		return
+	}
+	b.respond(ctx, responseURL, "질문을 받았어요. 답변을 준비하는 중...")
+
+	c := &chat.Chat{
+		Kind:        chat.KindSlashCommand,
+		Channel:     payload.ChannelID,
+		User:        payload.UserID,
+		ResponseURL: responseURL,
+		Thread: []chat.Message{
+			{Role: chat.RoleUser, Text: question},
+		},
+	}
+	c = c.WithContext(ctx)
+	b.chatHandler.HandleChat(c)
+}
+
+// receivedAt은 Bot이 이벤트를 받은 시각을 반환합니다. 알 수 없으면 현재 시각을 사용합니다.
+// response_url의 발급 시각으로 사용하여 대기열에서 기다린 시간도 사용 가능 시간에 포함한다.
+func receivedAt(ctx context.Context) time.Time {
+	if t := bot.ReceivedAtFrom(ctx); !t.IsZero() {
+		return t
+	}
+	return time.Now()
+}
+
+// respond는 슬래시 커맨드나 단축키를 실행한 사용자에게만 보이는 메시지를 보냅니다.
+func (b *BotHandler) respond(ctx context.Context, responseURL *api.ResponseURL, text string) {
+	if err := responseURL.Reply(ctx, interactive.Ephemeral, text, nil); err != nil {
+		slog.Warn("failed to respond to slash command", slog.Any("error", err))
+	}
+}
+
+func BuildChatHandlerChain(
+	config *Config,
+	generation *chain.Generation,
+	retrievers []chain.Retriever,
+	issueClient chain.IssueClient,
+	slackClient *api.Client,
+	openaiClient *openai.Client,
+	threadStore *thread.Store,
+) chat.Handler {
+	handler := chain.ChatResponse(config.JiraServer, config.SlashCommand.ResponseType)
 
 	// 메시지 생성 핸들러 설정.
-	handler = chain.ResponseGeneration(handler)
+	handler = chain.StreamingResponseGeneration(handler, time.Second, generation)
 	handler = chain.AssistantStatusUpdate(handler, "가 마법을 부리는 중...")
 
-	// 패시지 검색 핸들러 설정.
-	handler = chain.PassageRetrieval(handler)
+	// 패시지 검색 및 결합 핸들러 설정.
+	handler = chain.ContextPacking(handler, config.Generation.ContextBudget)
+	handler = chain.IssueKeyLookup(handler, issueClient, config.IssueRetrieval.LookupProjects, config.IssueRetrieval.Timeout)
+	handler = chain.IssueFiltering(handler)
+	handler = chain.IssueEnrichment(handler, issueClient, config.IssueRetrieval.TopK, config.IssueRetrieval.Timeout)
+	handler = chain.PassageFusion(handler, config.PassageFusion(), config.Fusion.TopN)
+	handler = chain.ProjectPreference(handler, config.ChannelProjects, config.ProjectBoost)
+	handler = chain.SearchFiltering(handler)
+	handler = chain.PassageRetrieval(handler, retrievers)
+
+	// 후속 질문을 독립적인 검색 질의로 재작성하는 핸들러 설정.
+	handler = chain.QueryRewrite(handler, cmp.Or(config.Generation.RewriteModel, generation.Model))
 	handler = chain.AssistantStatusUpdate(handler, "가 주문을 외우는 중...")
 
+	// 스레드 대화 기록 조회 핸들러 설정.
+	handler = chain.ThreadHistory(handler, 20)
+
+	// 스레드 상태 초기화.
+	handler = chain.ThreadContextInit(handler, threadStore)
+
 	// OpenAI 클라이언트 초기화.
 	handler = chain.WithChatClientInit(handler, openaiClient)
 	// Slack 클라이언트 초기화.
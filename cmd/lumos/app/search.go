@@ -0,0 +1,233 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chain"
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chat"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/slashcommand"
+)
+
+// AdvancedSearchCallbackID는 상세 검색 모달의 콜백 ID입니다.
+const AdvancedSearchCallbackID = "advanced_search"
+
+// 상세 검색 모달의 입력 블록 ID. 각 블록의 요소는 같은 값을 액션 ID로 사용한다.
+const (
+	searchQueryBlockID    = "search_query"
+	searchProjectBlockID  = "search_project"
+	searchStatusBlockID   = "search_status"
+	searchAssigneeBlockID = "search_assignee"
+	searchSinceBlockID    = "search_since"
+	searchUntilBlockID    = "search_until"
+)
+
+// searchMetadata는 모달을 제출했을 때 답변할 곳을 알 수 있도록 모달에 저장하는 정보입니다.
+type searchMetadata struct {
+	ChannelID   string    `json:"channel_id"`
+	ResponseURL string    `json:"response_url"`
+	IssuedAt    time.Time `json:"issued_at"`
+}
+
+// openAdvancedSearch는 슬래시 커맨드를 실행한 사용자에게 상세 검색 모달을 엽니다.
+// 모달을 제출하면 슬래시 커맨드의 response_url로 답변합니다.
+func (b *BotHandler) openAdvancedSearch(
+	ctx context.Context,
+	payload *slashcommand.Payload,
+	responseURL *api.ResponseURL,
+) error {
+	metadata, err := json.Marshal(searchMetadata{
+		ChannelID:   payload.ChannelID,
+		ResponseURL: responseURL.URL(),
+		IssuedAt:    responseURL.IssuedAt(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = b.slackClient.ViewsOpen(ctx, &api.ViewsOpenRequest{
+		TriggerID: payload.TriggerID,
+		View:      advancedSearchView(b.config.Projects(), b.issueFilterStatuses(), string(metadata)),
+	})
+	return err
+}
+
+// issueFilterStatuses는 상세 검색에서 선택할 수 있는 이슈 상태 목록을 반환합니다.
+// 이슈 검색 서비스를 사용하지 않으면 상태, 담당자, 기간 조건을 확인할 수 없으므로 nil을 반환합니다.
+func (b *BotHandler) issueFilterStatuses() []SearchStatus {
+	if b.config.IssueRetrieval.Disabled {
+		return nil
+	}
+	return b.config.SlashCommand.SearchStatuses
+}
+
+// advancedSearchView는 질문과 검색 조건을 입력받는 상세 검색 모달을 생성합니다.
+// 선택할 프로젝트가 없으면 프로젝트 키를 직접 입력받습니다.
+//
+// 상태, 담당자, 기간 조건은 이슈 정보로 확인하므로 선택할 상태가 없으면 해당 입력을 표시하지 않습니다.
+func advancedSearchView(projects []string, statuses []SearchStatus, metadata string) *blockkit.View {
+	projectElement := blockkit.NewBlockElementWithPlainTextInputElement(&blockkit.PlainTextInputElement{
+		ActionID:    searchProjectBlockID,
+		Placeholder: blockkit.NewPlainText("e.g., PAY, AUTH", false),
+	})
+	if len(projects) > 0 {
+		options := make([]*blockkit.OptionObject, 0, len(projects))
+		for _, p := range projects[:min(len(projects), 100)] {
+			options = append(options, blockkit.NewOption(p, p))
+		}
+		projectElement = blockkit.NewBlockElementWithStaticSelectElement(&blockkit.StaticSelectElement{
+			ActionID:    searchProjectBlockID,
+			Options:     options,
+			Placeholder: blockkit.NewPlainText("모든 프로젝트", false),
+		})
+	}
+
+	blocks := []*blockkit.Block{
+		inputBlock(searchQueryBlockID, "질문", false, blockkit.NewBlockElementWithPlainTextInputElement(&blockkit.PlainTextInputElement{
+			ActionID:    searchQueryBlockID,
+			Multiline:   true,
+			MaxLength:   3000,
+			FocusOnLoad: true,
+			Placeholder: blockkit.NewPlainText("e.g., 결제 승인이 실패하는 원인", false),
+		})),
+		inputBlock(searchProjectBlockID, "프로젝트", true, projectElement),
+	}
+	if len(statuses) > 0 {
+		statusOptions := make([]*blockkit.OptionObject, 0, len(statuses))
+		for _, s := range statuses[:min(len(statuses), 100)] {
+			statusOptions = append(statusOptions, blockkit.NewOption(cmp.Or(s.Label, s.Name), s.Name))
+		}
+		blocks = append(blocks,
+			inputBlock(searchStatusBlockID, "상태", true, blockkit.NewBlockElementWithStaticSelectElement(&blockkit.StaticSelectElement{
+				ActionID:    searchStatusBlockID,
+				Options:     statusOptions,
+				Placeholder: blockkit.NewPlainText("모든 상태", false),
+			})),
+			inputBlock(searchAssigneeBlockID, "담당자", true, blockkit.NewBlockElementWithPlainTextInputElement(&blockkit.PlainTextInputElement{
+				ActionID:    searchAssigneeBlockID,
+				Placeholder: blockkit.NewPlainText("Jira 담당자 이름", false),
+			})),
+			inputBlock(searchSinceBlockID, "시작일", true, blockkit.NewBlockElementWithDatePickerElement(&blockkit.DatePickerElement{
+				ActionID: searchSinceBlockID,
+			})),
+			inputBlock(searchUntilBlockID, "종료일", true, blockkit.NewBlockElementWithDatePickerElement(&blockkit.DatePickerElement{
+				ActionID: searchUntilBlockID,
+			})),
+		)
+	}
+
+	return &blockkit.View{
+		Type:            blockkit.ViewTypeModal,
+		CallbackID:      AdvancedSearchCallbackID,
+		Title:           blockkit.NewPlainText("상세 검색", false),
+		Submit:          blockkit.NewPlainText("검색", false),
+		Close:           blockkit.NewPlainText("취소", false),
+		PrivateMetadata: metadata,
+		Blocks:          blocks,
+	}
+}
+
+func inputBlock(blockID, label string, optional bool, element *blockkit.BlockElement) *blockkit.Block {
+	block := blockkit.NewBlockWithInputBlock(&blockkit.InputBlock{
+		Label:    blockkit.NewPlainText(label, false),
+		Element:  element,
+		Optional: optional,
+	})
+	block.ID = blockID
+	return block
+}
+
+// parseAdvancedSearch는 상세 검색 모달에 입력한 질문과 검색 조건을 읽습니다.
+func parseAdvancedSearch(state *interactive.ViewState) (string, *chain.SearchFilter) {
+	value := func(blockID string) string {
+		v, _ := state.Value(blockID, blockID)
+		switch {
+		case v.SelectedOption != nil:
+			return v.SelectedOption.Value
+		case v.SelectedDate != "":
+			return v.SelectedDate
+		}
+		return strings.TrimSpace(v.Value)
+	}
+
+	filter := &chain.SearchFilter{
+		Status:   value(searchStatusBlockID),
+		Assignee: value(searchAssigneeBlockID),
+		Since:    value(searchSinceBlockID),
+		Until:    value(searchUntilBlockID),
+	}
+	// 직접 입력한 프로젝트 키는 쉼표나 공백으로 구분한다.
+	for _, p := range strings.FieldsFunc(value(searchProjectBlockID), func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	}) {
+		filter.Projects = append(filter.Projects, strings.ToUpper(p))
+	}
+
+	return value(searchQueryBlockID), filter
+}
+
+// validateAdvancedSearch는 상세 검색 모달의 입력 값을 검사하고 입력 블록 ID별 오류 메시지를 반환합니다.
+func validateAdvancedSearch(question string, filter *chain.SearchFilter) map[string]string {
+	errs := make(map[string]string)
+	if question == "" {
+		errs[searchQueryBlockID] = "질문을 입력해주세요."
+	}
+	if filter.Since != "" && filter.Until != "" && filter.Since > filter.Until {
+		errs[searchUntilBlockID] = "종료일이 시작일보다 빨라요. 기간을 다시 선택해주세요."
+	}
+	return errs
+}
+
+// ValidateViewSubmission은 모달을 닫기 전에 상세 검색 모달의 입력 값을 검사합니다.
+// 오류가 있으면 모달이 닫히지 않고 해당 입력에 오류가 표시됩니다.
+func (b *BotHandler) ValidateViewSubmission(ctx context.Context, payload *interactive.ViewSubmissionPayload) map[string]string {
+	if payload.View == nil || payload.View.CallbackID != AdvancedSearchCallbackID {
+		return nil
+	}
+	return validateAdvancedSearch(parseAdvancedSearch(payload.View.State))
+}
+
+func (b *BotHandler) handleViewSubmission(ctx context.Context, payload *interactive.ViewSubmissionPayload) {
+	if payload.View == nil || payload.View.CallbackID != AdvancedSearchCallbackID {
+		slog.Warn("unknown view submission")
+		return
+	}
+
+	var metadata searchMetadata
+	if err := json.Unmarshal([]byte(payload.View.PrivateMetadata), &metadata); err != nil || metadata.ResponseURL == "" {
+		slog.Warn("failed to find where to respond advanced search", slog.Any("error", err))
+		return
+	}
+
+	// 모달을 연 프로세스라면 슬래시 커맨드를 받을 때 사용한 횟수를 이어서 센다.
+	responseURL := b.slackClient.NewResponseURL(metadata.ResponseURL, metadata.IssuedAt)
+
+	// 입력 값은 ValidateViewSubmission에서 모달을 닫기 전에 검사했다.
+	question, filter := parseAdvancedSearch(payload.View.State)
+	b.respond(ctx, responseURL, "질문을 받았어요. 답변을 준비하는 중...")
+
+	var user string
+	if payload.User != nil {
+		user = payload.User.ID
+	}
+
+	c := &chat.Chat{
+		Kind:        chat.KindSlashCommand,
+		Channel:     metadata.ChannelID,
+		User:        user,
+		ResponseURL: responseURL,
+		Thread: []chat.Message{
+			{Role: chat.RoleUser, Text: question},
+		},
+	}
+	c = c.WithContext(chain.WithSearchFilter(ctx, filter))
+	b.chatHandler.HandleChat(c)
+}
@@ -0,0 +1,162 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/cmd/lumos/app/chain"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+)
+
+func TestParseAdvancedSearch(t *testing.T) {
+	// state는 블록 ID와 액션 ID가 같은 상세 검색 모달의 입력 값을 만듭니다.
+	state := func(values map[string]interactive.ViewStateValue) *interactive.ViewState {
+		s := &interactive.ViewState{Values: make(map[string]map[string]interactive.ViewStateValue)}
+		for id, v := range values {
+			s.Values[id] = map[string]interactive.ViewStateValue{id: v}
+		}
+		return s
+	}
+
+	testCases := []struct {
+		desc         string
+		state        *interactive.ViewState
+		wantQuestion string
+		wantFilter   *chain.SearchFilter
+	}{
+		{
+			desc: "all conditions",
+			state: state(map[string]interactive.ViewStateValue{
+				searchQueryBlockID:    {Value: "  결제 승인 실패 원인\n"},
+				searchProjectBlockID:  {SelectedOption: blockkit.NewOption("PAY", "PAY")},
+				searchStatusBlockID:   {SelectedOption: blockkit.NewOption("진행 중", "In Progress")},
+				searchAssigneeBlockID: {Value: " 홍길동 "},
+				searchSinceBlockID:    {SelectedDate: "2025-01-01"},
+				searchUntilBlockID:    {SelectedDate: "2025-06-30"},
+			}),
+			wantQuestion: "결제 승인 실패 원인",
+			wantFilter: &chain.SearchFilter{
+				Projects: []string{"PAY"},
+				Status:   "In Progress",
+				Assignee: "홍길동",
+				Since:    "2025-01-01",
+				Until:    "2025-06-30",
+			},
+		},
+		{
+			desc: "typed projects",
+			state: state(map[string]interactive.ViewStateValue{
+				searchQueryBlockID:   {Value: "로그인 오류"},
+				searchProjectBlockID: {Value: "pay, auth\tuser"},
+			}),
+			wantQuestion: "로그인 오류",
+			wantFilter:   &chain.SearchFilter{Projects: []string{"PAY", "AUTH", "USER"}},
+		},
+		{
+			desc: "only question",
+			state: state(map[string]interactive.ViewStateValue{
+				searchQueryBlockID: {Value: "배포 절차"},
+			}),
+			wantQuestion: "배포 절차",
+			wantFilter:   &chain.SearchFilter{},
+		},
+		{
+			desc:       "nil state",
+			state:      nil,
+			wantFilter: &chain.SearchFilter{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			question, filter := parseAdvancedSearch(tc.state)
+			if question != tc.wantQuestion {
+				t.Errorf("got question %q, want %q", question, tc.wantQuestion)
+			}
+			if !reflect.DeepEqual(filter, tc.wantFilter) {
+				t.Errorf("got filter %+v, want %+v", filter, tc.wantFilter)
+			}
+		})
+	}
+}
+
+func TestValidateAdvancedSearch(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		question string
+		filter   *chain.SearchFilter
+		want     map[string]string
+	}{
+		{
+			desc:     "valid",
+			question: "결제 승인 실패 원인",
+			filter:   &chain.SearchFilter{Since: "2025-01-01", Until: "2025-01-01"},
+			want:     map[string]string{},
+		},
+		{
+			desc:   "empty question",
+			filter: &chain.SearchFilter{},
+			want:   map[string]string{searchQueryBlockID: "질문을 입력해주세요."},
+		},
+		{
+			desc:     "since after until",
+			question: "결제 승인 실패 원인",
+			filter:   &chain.SearchFilter{Since: "2025-02-01", Until: "2025-01-31"},
+			want:     map[string]string{searchUntilBlockID: "종료일이 시작일보다 빨라요. 기간을 다시 선택해주세요."},
+		},
+		{
+			desc:   "several errors",
+			filter: &chain.SearchFilter{Since: "2025-02-01", Until: "2025-01-31"},
+			want: map[string]string{
+				searchQueryBlockID: "질문을 입력해주세요.",
+				searchUntilBlockID: "종료일이 시작일보다 빨라요. 기간을 다시 선택해주세요.",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := validateAdvancedSearch(tc.question, tc.filter); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAdvancedSearchView(t *testing.T) {
+	statuses := []SearchStatus{{Name: "Done", Label: "완료"}, {Name: "Closed"}}
+
+	testCases := []struct {
+		desc     string
+		statuses []SearchStatus
+		want     []string
+	}{
+		{
+			desc:     "issue filters",
+			statuses: statuses,
+			want: []string{
+				searchQueryBlockID, searchProjectBlockID, searchStatusBlockID,
+				searchAssigneeBlockID, searchSinceBlockID, searchUntilBlockID,
+			},
+		},
+		{
+			desc: "issue retrieval disabled",
+			want: []string{searchQueryBlockID, searchProjectBlockID},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			view := advancedSearchView([]string{"PAY"}, tc.statuses, "{}")
+			if err := view.Validate(); err != nil {
+				t.Fatalf("invalid view: %v", err)
+			}
+
+			var got []string
+			for _, b := range view.Blocks {
+				got = append(got, b.ID)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got blocks %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package thread
+
+import (
+	"sync"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack"
+)
+
+// State는 어시스턴트 스레드별로 유지하는 상태입니다.
+type State struct {
+	// 사용자가 스레드를 열었을 때 보고 있던 채널 ID.
+	ContextChannelID string
+	// 상태가 마지막으로 갱신된 시각.
+	UpdatedAt time.Time
+}
+
+type key struct {
+	channel   string
+	timestamp slack.Timestamp
+}
+
+// Store는 어시스턴트 스레드의 상태를 메모리에 저장합니다.
+// ttl 동안 갱신되지 않은 상태는 다음 갱신 시점에 정리됩니다.
+type Store struct {
+	mu     sync.RWMutex
+	ttl    time.Duration
+	states map[key]State
+}
+
+func NewStore(ttl time.Duration) *Store {
+	return &Store{
+		ttl:    ttl,
+		states: make(map[key]State),
+	}
+}
+
+// Get은 주어진 스레드의 상태를 반환합니다.
+func (s *Store) Get(channel string, timestamp slack.Timestamp) (State, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	state, ok := s.states[key{channel: channel, timestamp: timestamp}]
+	if !ok || s.expired(state, time.Now()) {
+		return State{}, false
+	}
+	return state, true
+}
+
+// SetContextChannel은 주어진 스레드에서 사용자가 보고 있는 채널을 갱신합니다.
+func (s *Store) SetContextChannel(channel string, timestamp slack.Timestamp, contextChannelID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.prune(now)
+
+	k := key{channel: channel, timestamp: timestamp}
+	state := s.states[k]
+	state.ContextChannelID = contextChannelID
+	state.UpdatedAt = now
+	s.states[k] = state
+}
+
+func (s *Store) expired(state State, now time.Time) bool {
+	return s.ttl > 0 && now.Sub(state.UpdatedAt) > s.ttl
+}
+
+func (s *Store) prune(now time.Time) {
+	for k, state := range s.states {
+		if s.expired(state, now) {
+			delete(s.states, k)
+		}
+	}
+}
@@ -1,9 +1,7 @@
 package main
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"log/slog"
 	"net/http"
 	"os"
@@ -17,6 +15,7 @@ import (
 	"github.com/joyfuldevs/project-lumos/pkg/slack/bot"
 	"github.com/joyfuldevs/project-lumos/pkg/slack/eventsapi"
 	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/slashcommand"
 )
 
 type Handler struct {
@@ -121,27 +120,14 @@ func (h *Handler) HandleInteractive(ctx context.Context, payload *interactive.Pa
 		for _, action := range payload.OfBlockActions.Actions {
 			slog.Info("action", slog.String("id", action.ActionID))
 		}
-		rp := &interactive.ResponsePayload{
-			ResponseType:    interactive.Ephemeral,
-			Text:            "Submitted!",
-			ReplaceOriginal: true,
-		}
-		body, err := json.Marshal(rp)
-		if err != nil {
-			slog.Error("failed to marshal response", slog.Any("error", err))
-			return
-		}
-		req, err := http.NewRequest("POST", payload.OfBlockActions.ResponseURL, bytes.NewReader(body))
-		if err != nil {
-			slog.Error("failed to create request", slog.Any("error", err))
-			return
-		}
-		if _, err := http.DefaultClient.Do(req); err != nil {
+		c := api.NewClient(http.DefaultClient, h.appToken, h.botToken)
+		responseURL := c.NewResponseURL(payload.OfBlockActions.ResponseURL, bot.ReceivedAtFrom(ctx))
+		if err := responseURL.Replace(ctx, "Submitted!", nil); err != nil {
 			slog.Info("failed to send response", slog.Any("error", err))
 		}
 
 	case interactive.PayloadTypeMessageActions:
-		slog.Info("received message actions")
+		slog.Info("received message actions", slog.String("callback_id", payload.OfMessageActions.CallbackID))
 	case interactive.PayloadTypeViewClosed:
 		slog.Info("received view closed")
 	case interactive.PayloadTypeViewSubmission:
@@ -151,6 +137,18 @@ func (h *Handler) HandleInteractive(ctx context.Context, payload *interactive.Pa
 	}
 }
 
+func (h *Handler) HandleSlashCommand(ctx context.Context, payload *slashcommand.Payload) {
+	slog.Info("received slash command",
+		slog.String("command", payload.Command),
+		slog.String("text", payload.Text))
+
+	c := api.NewClient(http.DefaultClient, h.appToken, h.botToken)
+	responseURL := c.NewResponseURL(payload.ResponseURL, bot.ReceivedAtFrom(ctx))
+	if err := responseURL.Reply(ctx, interactive.Ephemeral, payload.Command+" "+payload.Text, nil); err != nil {
+		slog.Error("failed to respond", slog.Any("error", err))
+	}
+}
+
 func main() {
 	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
 
@@ -170,13 +168,9 @@ func main() {
 	}()
 
 	c := api.NewClient(http.DefaultClient, appToken, botToken)
-	resp, err := c.OpenConnection(ctx)
-	if err != nil {
-		return
-	}
 
 	b := bot.NewBot(&Handler{appToken: appToken, botToken: botToken})
-	if err := b.Run(ctx, resp.URL); err != nil {
+	if err := b.Serve(ctx, c); err != nil {
 		slog.Error("failed to run bot", slog.Any("error", err))
 	}
 }
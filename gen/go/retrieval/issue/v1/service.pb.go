@@ -123,7 +123,15 @@ type Issue struct {
 	// 이슈 내용.
 	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	// 이슈 댓글.
-	Comments      []string `protobuf:"bytes,4,rep,name=comments,proto3" json:"comments,omitempty"`
+	Comments []string `protobuf:"bytes,4,rep,name=comments,proto3" json:"comments,omitempty"`
+	// 이슈 상태. e.g., In Progress
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// 이슈 담당자 표시 이름. 담당자가 없으면 비어있습니다.
+	Assignee string `protobuf:"bytes,6,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	// 이슈 생성일. RFC 3339 형식입니다.
+	Created string `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	// 이슈 수정일. RFC 3339 형식입니다.
+	Updated       string `protobuf:"bytes,8,opt,name=updated,proto3" json:"updated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -186,6 +194,34 @@ func (x *Issue) GetComments() []string {
 	return nil
 }
 
+func (x *Issue) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Issue) GetAssignee() string {
+	if x != nil {
+		return x.Assignee
+	}
+	return ""
+}
+
+func (x *Issue) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *Issue) GetUpdated() string {
+	if x != nil {
+		return x.Updated
+	}
+	return ""
+}
+
 var File_retrieval_issue_v1_service_proto protoreflect.FileDescriptor
 
 const file_retrieval_issue_v1_service_proto_rawDesc = "" +
@@ -195,12 +231,16 @@ const file_retrieval_issue_v1_service_proto_rawDesc = "" +
 	"\n" +
 	"issue_keys\x18\x01 \x03(\tR\tissueKeys\"E\n" +
 	"\x10RetrieveResponse\x121\n" +
-	"\x06issues\x18\x01 \x03(\v2\x19.retrieval.issue.v1.IssueR\x06issues\"e\n" +
+	"\x06issues\x18\x01 \x03(\v2\x19.retrieval.issue.v1.IssueR\x06issues\"\xcd\x01\n" +
 	"\x05Issue\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1a\n" +
-	"\bcomments\x18\x04 \x03(\tR\bcomments2n\n" +
+	"\bcomments\x18\x04 \x03(\tR\bcomments\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\bassignee\x18\x06 \x01(\tR\bassignee\x12\x18\n" +
+	"\acreated\x18\a \x01(\tR\acreated\x12\x18\n" +
+	"\aupdated\x18\b \x01(\tR\aupdated2n\n" +
 	"\x15IssueRetrievalService\x12U\n" +
 	"\bRetrieve\x12#.retrieval.issue.v1.RetrieveRequest\x1a$.retrieval.issue.v1.RetrieveResponseBDZBgithub.com/joyfuldevs/project-lumos/proto/retrieval/issue/v1;issueb\x06proto3"
 
@@ -24,7 +24,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n retrieval/issue/v1/service.proto\x12\x12retrieval.issue.v1\"%\n\x0fRetrieveRequest\x12\x12\n\nissue_keys\x18\x01 \x03(\t\"=\n\x10RetrieveResponse\x12)\n\x06issues\x18\x01 \x03(\x0b\x32\x19.retrieval.issue.v1.Issue\"F\n\x05Issue\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05title\x18\x02 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\t\x12\x10\n\x08\x63omments\x18\x04 \x03(\t2n\n\x15IssueRetrievalService\x12U\n\x08Retrieve\x12#.retrieval.issue.v1.RetrieveRequest\x1a$.retrieval.issue.v1.RetrieveResponseBDZBgithub.com/joyfuldevs/project-lumos/proto/retrieval/issue/v1;issueb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n retrieval/issue/v1/service.proto\x12\x12retrieval.issue.v1\"%\n\x0fRetrieveRequest\x12\x12\n\nissue_keys\x18\x01 \x03(\t\"=\n\x10RetrieveResponse\x12)\n\x06issues\x18\x01 \x03(\x0b\x32\x19.retrieval.issue.v1.Issue\"\x8a\x01\n\x05Issue\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05title\x18\x02 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\t\x12\x10\n\x08\x63omments\x18\x04 \x03(\t\x12\x0e\n\x06status\x18\x05 \x01(\t\x12\x10\n\x08\x61ssignee\x18\x06 \x01(\t\x12\x0f\n\x07\x63reated\x18\x07 \x01(\t\x12\x0f\n\x07updated\x18\x08 \x01(\t2n\n\x15IssueRetrievalService\x12U\n\x08Retrieve\x12#.retrieval.issue.v1.RetrieveRequest\x1a$.retrieval.issue.v1.RetrieveResponseBDZBgithub.com/joyfuldevs/project-lumos/proto/retrieval/issue/v1;issueb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -36,8 +36,8 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_RETRIEVEREQUEST']._serialized_end=93
   _globals['_RETRIEVERESPONSE']._serialized_start=95
   _globals['_RETRIEVERESPONSE']._serialized_end=156
-  _globals['_ISSUE']._serialized_start=158
-  _globals['_ISSUE']._serialized_end=228
-  _globals['_ISSUERETRIEVALSERVICE']._serialized_start=230
-  _globals['_ISSUERETRIEVALSERVICE']._serialized_end=340
+  _globals['_ISSUE']._serialized_start=159
+  _globals['_ISSUE']._serialized_end=297
+  _globals['_ISSUERETRIEVALSERVICE']._serialized_start=299
+  _globals['_ISSUERETRIEVALSERVICE']._serialized_end=409
 # @@protoc_insertion_point(module_scope)
@@ -19,13 +19,21 @@ class RetrieveResponse(_message.Message):
     def __init__(self, issues: _Optional[_Iterable[_Union[Issue, _Mapping]]] = ...) -> None: ...
 
 class Issue(_message.Message):
-    __slots__ = ("key", "title", "content", "comments")
+    __slots__ = ("key", "title", "content", "comments", "status", "assignee", "created", "updated")
     KEY_FIELD_NUMBER: _ClassVar[int]
     TITLE_FIELD_NUMBER: _ClassVar[int]
     CONTENT_FIELD_NUMBER: _ClassVar[int]
     COMMENTS_FIELD_NUMBER: _ClassVar[int]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    ASSIGNEE_FIELD_NUMBER: _ClassVar[int]
+    CREATED_FIELD_NUMBER: _ClassVar[int]
+    UPDATED_FIELD_NUMBER: _ClassVar[int]
     key: str
     title: str
     content: str
     comments: _containers.RepeatedScalarFieldContainer[str]
-    def __init__(self, key: _Optional[str] = ..., title: _Optional[str] = ..., content: _Optional[str] = ..., comments: _Optional[_Iterable[str]] = ...) -> None: ...
+    status: str
+    assignee: str
+    created: str
+    updated: str
+    def __init__(self, key: _Optional[str] = ..., title: _Optional[str] = ..., content: _Optional[str] = ..., comments: _Optional[_Iterable[str]] = ..., status: _Optional[str] = ..., assignee: _Optional[str] = ..., created: _Optional[str] = ..., updated: _Optional[str] = ...) -> None: ...
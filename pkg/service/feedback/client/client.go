@@ -0,0 +1,45 @@
+package client
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	feedbackv1 "github.com/joyfuldevs/project-lumos/gen/go/feedback/v1"
+)
+
+// Client는 피드백 서비스를 위한 클라이언트 API입니다.
+type Client struct {
+	options *clientOptions
+
+	grpcClient *grpc.ClientConn
+	serviceV1  feedbackv1.FeedbackServiceClient
+}
+
+// NewClient는 새로운 피드백 서비스 클라이언트를 생성합니다.
+func NewClient(opts ...Option) (*Client, error) {
+	options := defaultClientOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	grpcClient, err := grpc.NewClient(
+		net.JoinHostPort(options.host, options.port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		options:    &options,
+		grpcClient: grpcClient,
+		serviceV1:  feedbackv1.NewFeedbackServiceClient(grpcClient),
+	}, nil
+}
+
+// Close는 피드백 서비스에 대한 연결을 종료합니다.
+func (c *Client) Close() error {
+	return c.grpcClient.Close()
+}
@@ -0,0 +1,17 @@
+package client
+
+import (
+	"context"
+
+	"github.com/joyfuldevs/project-lumos/gen/go/feedback/v1"
+)
+
+// SubmitFeedbackV1은 주어진 스레드에 대한 사용자의 피드백을 제출합니다.
+func (c *Client) SubmitFeedbackV1(ctx context.Context, feedbackType feedback.FeedbackType, thread []string) error {
+	req := &feedback.SubmitFeedbackRequest{
+		Type:   feedbackType,
+		Thread: thread,
+	}
+	_, err := c.serviceV1.SubmitFeedback(ctx, req)
+	return err
+}
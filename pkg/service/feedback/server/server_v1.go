@@ -0,0 +1,24 @@
+package server
+
+import (
+	"context"
+
+	feedbackv1 "github.com/joyfuldevs/project-lumos/gen/go/feedback/v1"
+)
+
+type ServiceV1 interface {
+	SubmitFeedback(ctx context.Context, feedbackType feedbackv1.FeedbackType, thread []string) error
+}
+
+type serverV1 struct {
+	feedbackv1.UnimplementedFeedbackServiceServer
+
+	service ServiceV1
+}
+
+func (s *serverV1) SubmitFeedback(ctx context.Context, req *feedbackv1.SubmitFeedbackRequest) (*feedbackv1.SubmitFeedbackResponse, error) {
+	if err := s.service.SubmitFeedback(ctx, req.Type, req.Thread); err != nil {
+		return nil, err
+	}
+	return &feedbackv1.SubmitFeedbackResponse{}, nil
+}
@@ -9,6 +9,12 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
 )
 
 const baseURL = "https://slack.com/api"
@@ -18,6 +24,10 @@ type Client struct {
 
 	AppToken string
 	BotToken string
+
+	// response_url별 사용 현황. NewResponseURL이 같은 URL에 같은 ResponseURL을 반환하도록 보관합니다.
+	responseURLsMu sync.Mutex
+	responseURLs   map[string]*ResponseURL
 }
 
 func NewClient(clitn *http.Client, appToken, botToken string) *Client {
@@ -49,7 +59,7 @@ func (c *Client) OpenConnection(ctx context.Context) (*OpenConnectionResponse, e
 	}
 
 	if !result.OK || result.Error != "" {
-		return nil, errors.New(result.Error)
+		return nil, &APIError{Code: result.Error}
 	}
 
 	return result, nil
@@ -62,6 +72,10 @@ func (c *Client) PostMessage(
 ) (*PostMessageResponse, error) {
 	path := "chat.postMessage"
 
+	if err := blockkit.ValidateBlocks(req.Blocks); err != nil {
+		return nil, fmt.Errorf("invalid blocks: %w", err)
+	}
+
 	r, err := c.newRequest(ctx, "POST", c.BotToken, path, req)
 	if err != nil {
 		return nil, err
@@ -78,7 +92,40 @@ func (c *Client) PostMessage(
 	}
 
 	if !result.OK || result.Error != "" {
-		return nil, errors.New(result.Error)
+		return nil, &APIError{Code: result.Error}
+	}
+
+	return result, nil
+}
+
+// Updates a message.
+func (c *Client) UpdateMessage(
+	ctx context.Context,
+	req *UpdateMessageRequest,
+) (*UpdateMessageResponse, error) {
+	path := "chat.update"
+
+	if err := blockkit.ValidateBlocks(req.Blocks); err != nil {
+		return nil, fmt.Errorf("invalid blocks: %w", err)
+	}
+
+	r, err := c.newRequest(ctx, "POST", c.BotToken, path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.sendRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UpdateMessageResponse{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+
+	if !result.OK || result.Error != "" {
+		return nil, &APIError{Code: result.Error}
 	}
 
 	return result, nil
@@ -107,7 +154,7 @@ func (c *Client) AssistantSetStatus(
 	}
 
 	if !result.OK || result.Error != "" {
-		return nil, errors.New(result.Error)
+		return nil, &APIError{Code: result.Error}
 	}
 
 	return result, nil
@@ -136,12 +183,234 @@ func (c *Client) AssistantSetSuggestedPrompts(
 	}
 
 	if !result.OK || result.Error != "" {
-		return nil, errors.New(result.Error)
+		return nil, &APIError{Code: result.Error}
+	}
+
+	return result, nil
+}
+
+// Retrieve a thread of messages posted to a conversation.
+//
+// Only a single page is returned. Use ConversationsRepliesAll to follow the cursor.
+func (c *Client) ConversationsReplies(
+	ctx context.Context,
+	req *ConversationsRepliesRequest,
+) (*ConversationsRepliesResponse, error) {
+	path := "conversations.replies"
+
+	r, err := c.newQueryRequest(ctx, c.BotToken, path, req.values())
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.sendRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConversationsRepliesResponse{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+
+	if !result.OK || result.Error != "" {
+		return nil, &APIError{Code: result.Error}
+	}
+
+	return result, nil
+}
+
+// Retrieve every message of a thread by following the pagination cursor.
+func (c *Client) ConversationsRepliesAll(
+	ctx context.Context,
+	channel string,
+	timestamp slack.Timestamp,
+) ([]Message, error) {
+	req := &ConversationsRepliesRequest{
+		Channel:   channel,
+		Timestamp: timestamp,
+		Limit:     200,
+	}
+
+	var messages []Message
+	for {
+		resp, err := c.ConversationsReplies(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, resp.Messages...)
+
+		if !resp.HasMore || resp.ResponseMetadata.NextCursor == "" {
+			break
+		}
+		req.Cursor = resp.ResponseMetadata.NextCursor
+	}
+
+	return messages, nil
+}
+
+// Adds a reaction to an item.
+func (c *Client) ReactionsAdd(
+	ctx context.Context,
+	req *ReactionsAddRequest,
+) (*ReactionsAddResponse, error) {
+	path := "reactions.add"
+
+	r, err := c.newRequest(ctx, "POST", c.BotToken, path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.sendRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ReactionsAddResponse{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+
+	if !result.OK || result.Error != "" {
+		return nil, &APIError{Code: result.Error}
+	}
+
+	return result, nil
+}
+
+// Removes a reaction from an item.
+func (c *Client) ReactionsRemove(
+	ctx context.Context,
+	req *ReactionsRemoveRequest,
+) (*ReactionsRemoveResponse, error) {
+	path := "reactions.remove"
+
+	r, err := c.newRequest(ctx, "POST", c.BotToken, path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.sendRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ReactionsRemoveResponse{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+
+	if !result.OK || result.Error != "" {
+		return nil, &APIError{Code: result.Error}
+	}
+
+	return result, nil
+}
+
+// Open a view for a user.
+func (c *Client) ViewsOpen(ctx context.Context, req *ViewsOpenRequest) (*ViewsOpenResponse, error) {
+	path := "views.open"
+
+	if err := req.View.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid view: %w", err)
+	}
+
+	r, err := c.newRequest(ctx, "POST", c.BotToken, path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.sendRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ViewsOpenResponse{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+
+	if !result.OK || result.Error != "" {
+		return nil, &APIError{Code: result.Error}
+	}
+
+	return result, nil
+}
+
+// Update an existing view.
+func (c *Client) ViewsUpdate(ctx context.Context, req *ViewsUpdateRequest) (*ViewsUpdateResponse, error) {
+	path := "views.update"
+
+	if err := req.View.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid view: %w", err)
+	}
+
+	r, err := c.newRequest(ctx, "POST", c.BotToken, path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.sendRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ViewsUpdateResponse{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+
+	if !result.OK || result.Error != "" {
+		return nil, &APIError{Code: result.Error}
 	}
 
 	return result, nil
 }
 
+// Respond는 슬래시 커맨드나 상호작용 페이로드의 response_url로 메시지를 보냅니다.
+// response_url은 토큰 없이 호출할 수 있으며, 발급 후 30분 동안 최대 5번 사용할 수 있습니다.
+// 사용 횟수와 만료 시각을 추적하려면 NewResponseURL로 생성한 ResponseURL을 사용합니다.
+func (c *Client) Respond(ctx context.Context, responseURL string, payload *interactive.ResponsePayload) error {
+	if err := blockkit.ValidateBlocks(payload.Blocks); err != nil {
+		return fmt.Errorf("invalid blocks: %w", err)
+	}
+	return c.sendResponse(ctx, responseURL, payload)
+}
+
+// sendResponse는 검증을 마친 페이로드를 response_url로 보냅니다.
+func (c *Client) sendResponse(ctx context.Context, responseURL string, payload *interactive.ResponsePayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	r, err := http.NewRequestWithContext(ctx, "POST", responseURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	r.Header.Add("Content-Type", "application/json")
+
+	data, err := c.sendRequest(r)
+	if err != nil {
+		return err
+	}
+
+	result := &APIResponse{}
+	if err := json.Unmarshal(data, result); err != nil {
+		// JSON 대신 "ok" 문자열만 응답하는 경우가 있다.
+		if string(bytes.TrimSpace(data)) == "ok" {
+			return nil
+		}
+		return err
+	}
+
+	if !result.OK || result.Error != "" {
+		return &APIError{Code: result.Error}
+	}
+
+	return nil
+}
+
 func (c *Client) newRequest(
 	ctx context.Context,
 	method string,
@@ -171,6 +440,28 @@ func (c *Client) newRequest(
 	return req, nil
 }
 
+// newQueryRequest는 JSON 본문을 받지 않는 API 메서드를 위해 쿼리 문자열로 요청을 생성합니다.
+func (c *Client) newQueryRequest(
+	ctx context.Context,
+	token string,
+	path string,
+	values url.Values,
+) (*http.Request, error) {
+	u := fmt.Sprintf("%s/%s", baseURL, path)
+	if len(values) > 0 {
+		u += "?" + values.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	return req, nil
+}
+
 func (c *Client) sendRequest(req *http.Request) ([]byte, error) {
 	resp, err := c.client.Do(req)
 	if err != nil {
@@ -0,0 +1,101 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
+)
+
+// serverTransport는 Slack API로 보내는 요청을 테스트 서버로 보냅니다.
+type serverTransport struct {
+	server *httptest.Server
+}
+
+func (t *serverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	u, err := url.Parse(t.server.URL)
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.URL.Scheme = u.Scheme
+	req.URL.Host = u.Host
+	return t.server.Client().Transport.RoundTrip(req)
+}
+
+func TestConversationsRepliesAll(t *testing.T) {
+	pages := map[string]string{
+		"": `{
+			"ok": true,
+			"messages": [{"text": "질문", "user": "U1", "ts": "1.0"}, {"text": "답변", "bot_id": "B1", "ts": "2.0"}],
+			"has_more": true,
+			"response_metadata": {"next_cursor": "page2"}
+		}`,
+		"page2": `{
+			"ok": true,
+			"messages": [{"text": "추가 질문", "user": "U1", "ts": "3.0"}],
+			"has_more": false,
+			"response_metadata": {"next_cursor": ""}
+		}`,
+	}
+
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/api/conversations.replies" || q.Get("channel") != "C1" || q.Get("ts") != "1.0" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		cursor := q.Get("cursor")
+		cursors = append(cursors, cursor)
+		_, _ = fmt.Fprint(w, pages[cursor])
+	}))
+	defer server.Close()
+
+	client := api.NewClient(&http.Client{Transport: &serverTransport{server: server}}, "", "")
+	messages, err := client.ConversationsRepliesAll(context.Background(), "C1", "1.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := []string{"", "page2"}; !slices.Equal(cursors, want) {
+		t.Errorf("got cursors %q, want %q", cursors, want)
+	}
+
+	var texts []string
+	for _, m := range messages {
+		texts = append(texts, m.Text)
+	}
+	if want := []string{"질문", "답변", "추가 질문"}; !slices.Equal(texts, want) {
+		t.Errorf("got messages %q, want %q", texts, want)
+	}
+	if messages[1].BotID != "B1" {
+		t.Errorf("got bot id %q, want B1", messages[1].BotID)
+	}
+}
+
+func TestConversationsRepliesAllError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "" {
+			_, _ = fmt.Fprint(w, `{"ok": true, "messages": [{"text": "질문"}], "has_more": true, "response_metadata": {"next_cursor": "page2"}}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"ok": false, "error": "ratelimited"}`)
+	}))
+	defer server.Close()
+
+	client := api.NewClient(&http.Client{Transport: &serverTransport{server: server}}, "", "")
+	messages, err := client.ConversationsRepliesAll(context.Background(), "C1", "1.0")
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "ratelimited" {
+		t.Errorf("got error %v, want ratelimited api error", err)
+	}
+	if messages != nil {
+		t.Errorf("got %d messages, want none", len(messages))
+	}
+}
@@ -0,0 +1,13 @@
+package api
+
+// APIError는 Slack Web API가 ok가 false인 응답으로 보낸 에러입니다.
+//
+// 에러 코드에 따라 처리해야 하는 경우 errors.As로 확인합니다.
+type APIError struct {
+	// 응답의 error 필드. e.g., "already_reacted"
+	Code string
+}
+
+func (e *APIError) Error() string {
+	return e.Code
+}
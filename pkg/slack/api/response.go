@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+)
+
+// response_url 사용 제한.
+const (
+	// response_url을 사용할 수 있는 최대 횟수.
+	ResponseURLMaxUses = 5
+	// response_url을 발급받은 뒤 사용할 수 있는 시간.
+	ResponseURLLifetime = 30 * time.Minute
+)
+
+var (
+	// response_url이 비어 있습니다.
+	ErrResponseURLMissing = errors.New("response_url is missing")
+	// response_url을 발급받은 지 30분이 지났습니다.
+	ErrResponseURLExpired = errors.New("response_url has expired")
+	// response_url을 이미 최대 횟수만큼 사용했습니다.
+	ErrResponseURLUsedUp = errors.New("response_url has been used up")
+)
+
+// ResponseURL은 슬래시 커맨드나 상호작용 페이로드로 받은 response_url로 메시지를 보냅니다.
+//
+// 사용 횟수와 발급 시각을 추적하여 Slack의 사용 제한을 넘는 요청은 보내지 않고
+// ErrResponseURLExpired나 ErrResponseURLUsedUp을 감싼 오류를 반환합니다.
+// 사용 현황은 Client에 URL별로 보관되므로 같은 URL로 여러 번 NewResponseURL을 호출해도 함께 셉니다.
+// 다만 프로세스 안에서만 추적하므로 다른 프로세스가 같은 URL을 사용했거나
+// 프로세스가 다시 시작되었다면 Slack이 요청을 거절할 수 있습니다.
+//
+// 여러 고루틴에서 동시에 사용할 수 있습니다.
+type ResponseURL struct {
+	client   *Client
+	url      string
+	issuedAt time.Time
+
+	mu   sync.Mutex
+	uses int
+}
+
+// NewResponseURL은 issuedAt에 발급된 response_url로 메시지를 보내는 ResponseURL을 반환합니다.
+// 발급 시각은 보통 페이로드를 받은 시각을 사용합니다.
+//
+// 이미 추적 중인 URL이면 사용 횟수를 이어서 세는 기존 ResponseURL을 반환합니다.
+// 만료된 URL은 이때 함께 정리합니다.
+func (c *Client) NewResponseURL(url string, issuedAt time.Time) *ResponseURL {
+	c.responseURLsMu.Lock()
+	defer c.responseURLsMu.Unlock()
+
+	if c.responseURLs == nil {
+		c.responseURLs = make(map[string]*ResponseURL)
+	}
+	for u, r := range c.responseURLs {
+		if time.Since(r.issuedAt) > ResponseURLLifetime {
+			delete(c.responseURLs, u)
+		}
+	}
+
+	if r, ok := c.responseURLs[url]; ok {
+		return r
+	}
+	r := &ResponseURL{
+		client:   c,
+		url:      url,
+		issuedAt: issuedAt,
+	}
+	if url != "" {
+		c.responseURLs[url] = r
+	}
+	return r
+}
+
+func (r *ResponseURL) URL() string {
+	return r.url
+}
+
+func (r *ResponseURL) IssuedAt() time.Time {
+	return r.issuedAt
+}
+
+// Remaining은 남은 사용 횟수를 반환합니다. 만료되었으면 0을 반환합니다.
+func (r *ResponseURL) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.url == "" || time.Since(r.issuedAt) > ResponseURLLifetime {
+		return 0
+	}
+	return ResponseURLMaxUses - r.uses
+}
+
+// Send는 response_url로 페이로드를 보냅니다.
+//
+// 블록이 올바르지 않으면 사용 횟수를 차감하지 않고 오류를 반환합니다.
+// 요청이 실패하더라도 Slack이 이미 사용 횟수를 셌을 수 있으므로
+// 요청을 보내기 전에 사용 횟수를 차감합니다.
+func (r *ResponseURL) Send(ctx context.Context, payload *interactive.ResponsePayload) error {
+	if err := blockkit.ValidateBlocks(payload.Blocks); err != nil {
+		return fmt.Errorf("invalid blocks: %w", err)
+	}
+	if err := r.use(); err != nil {
+		return err
+	}
+	return r.client.sendResponse(ctx, r.url, payload)
+}
+
+// Reply는 원본 메시지를 그대로 두고 responseType에 맞게 새 메시지를 보냅니다.
+func (r *ResponseURL) Reply(
+	ctx context.Context,
+	responseType interactive.ResponseType,
+	text string,
+	blocks []*blockkit.Block,
+) error {
+	return r.Send(ctx, &interactive.ResponsePayload{
+		ResponseType: responseType,
+		Text:         text,
+		Blocks:       blocks,
+	})
+}
+
+// Replace는 상호작용이 일어난 원본 메시지의 내용을 교체합니다.
+// 원본 메시지의 공개 범위는 바뀌지 않습니다.
+func (r *ResponseURL) Replace(ctx context.Context, text string, blocks []*blockkit.Block) error {
+	return r.Send(ctx, &interactive.ResponsePayload{
+		Text:            text,
+		Blocks:          blocks,
+		ReplaceOriginal: true,
+	})
+}
+
+// Delete는 상호작용이 일어난 원본 메시지를 삭제합니다.
+func (r *ResponseURL) Delete(ctx context.Context) error {
+	return r.Send(ctx, &interactive.ResponsePayload{DeleteOriginal: true})
+}
+
+func (r *ResponseURL) use() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.url == "" {
+		return ErrResponseURLMissing
+	}
+	if age := time.Since(r.issuedAt); age > ResponseURLLifetime {
+		return fmt.Errorf("%w: issued %s ago", ErrResponseURLExpired, age.Round(time.Second))
+	}
+	if r.uses >= ResponseURLMaxUses {
+		return fmt.Errorf("%w: already used %d times", ErrResponseURLUsedUp, r.uses)
+	}
+	r.uses++
+	return nil
+}
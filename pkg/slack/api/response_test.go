@@ -0,0 +1,142 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+)
+
+func TestResponseURL(t *testing.T) {
+	var received []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		received = append(received, body)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(server.Client(), "", "")
+	ctx := context.Background()
+
+	testCases := []struct {
+		desc     string
+		issuedAt time.Time
+		send     func(r *api.ResponseURL) error
+		want     map[string]any
+		wantErr  error
+	}{
+		{
+			desc:     "reply",
+			issuedAt: time.Now(),
+			send: func(r *api.ResponseURL) error {
+				return r.Reply(ctx, interactive.InChannel, "답변", nil)
+			},
+			want: map[string]any{"response_type": "in_channel", "text": "답변", "replace_original": false, "delete_original": false},
+		},
+		{
+			desc:     "replace",
+			issuedAt: time.Now(),
+			send: func(r *api.ResponseURL) error {
+				return r.Replace(ctx, "감사합니다", nil)
+			},
+			want: map[string]any{"text": "감사합니다", "replace_original": true, "delete_original": false},
+		},
+		{
+			desc:     "delete",
+			issuedAt: time.Now(),
+			send:     func(r *api.ResponseURL) error { return r.Delete(ctx) },
+			want:     map[string]any{"replace_original": false, "delete_original": true},
+		},
+		{
+			desc:     "expired",
+			issuedAt: time.Now().Add(-api.ResponseURLLifetime - time.Minute),
+			send:     func(r *api.ResponseURL) error { return r.Delete(ctx) },
+			wantErr:  api.ErrResponseURLExpired,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			received = nil
+			err := tc.send(client.NewResponseURL(fmt.Sprintf("%s/%d", server.URL, i), tc.issuedAt))
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tc.wantErr)
+			}
+			if tc.wantErr != nil {
+				if len(received) != 0 {
+					t.Errorf("sent %d requests, want none", len(received))
+				}
+				return
+			}
+			if len(received) != 1 {
+				t.Fatalf("sent %d requests, want 1", len(received))
+			}
+			got, _ := json.Marshal(received[0])
+			want, _ := json.Marshal(tc.want)
+			if string(got) != string(want) {
+				t.Errorf("payload = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestResponseURLUsedUp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	r := api.NewClient(server.Client(), "", "").NewResponseURL(server.URL, time.Now())
+	for i := range api.ResponseURLMaxUses {
+		if err := r.Reply(context.Background(), interactive.Ephemeral, "ok", nil); err != nil {
+			t.Fatalf("use %d: unexpected error: %v", i+1, err)
+		}
+	}
+	if got := r.Remaining(); got != 0 {
+		t.Errorf("Remaining() = %d, want 0", got)
+	}
+	if err := r.Delete(context.Background()); !errors.Is(err, api.ErrResponseURLUsedUp) {
+		t.Errorf("error = %v, want %v", err, api.ErrResponseURLUsedUp)
+	}
+}
+
+func TestResponseURLSharedByURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(server.Client(), "", "")
+	issuedAt := time.Now().Add(-time.Minute)
+	first := client.NewResponseURL(server.URL+"/a", issuedAt)
+	for range 3 {
+		if err := first.Delete(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// 같은 URL은 사용 횟수와 발급 시각을 이어받는다.
+	second := client.NewResponseURL(server.URL+"/a", time.Now())
+	if got := second.Remaining(); got != api.ResponseURLMaxUses-3 {
+		t.Errorf("Remaining() = %d, want %d", got, api.ResponseURLMaxUses-3)
+	}
+	if !second.IssuedAt().Equal(issuedAt) {
+		t.Errorf("IssuedAt() = %v, want %v", second.IssuedAt(), issuedAt)
+	}
+
+	other := client.NewResponseURL(server.URL+"/b", time.Now())
+	if got := other.Remaining(); got != api.ResponseURLMaxUses {
+		t.Errorf("Remaining() of other URL = %d, want %d", got, api.ResponseURLMaxUses)
+	}
+}
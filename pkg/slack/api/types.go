@@ -1,8 +1,12 @@
 package api
 
 import (
+	"net/url"
+	"strconv"
+
 	"github.com/joyfuldevs/project-lumos/pkg/slack"
 	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
 )
 
 type APIResponse struct {
@@ -71,6 +75,34 @@ type PostMessageResponse struct {
 	Timestamp slack.Timestamp `json:"ts"`
 }
 
+type UpdateMessageRequest struct {
+	// Channel containing the message to be updated.
+	Channel string `json:"channel"`
+	// Timestamp of the message to be updated.
+	Timestamp slack.Timestamp `json:"ts"`
+	// New text for the message, using the default formatting rules.
+	// It's not required when presenting blocks.
+	Text string `json:"text,omitempty"`
+	// A JSON-based array of structured blocks, presented as a URL-encoded string.
+	// If you don't include this field, the message's previous blocks will be retained.
+	// To remove previous blocks, include an empty array for this field.
+	Blocks []*blockkit.Block `json:"blocks,omitempty"`
+	// Find and link channel names and usernames.
+	LinkNames bool `json:"link_names,omitempty"`
+	// Change how messages are treated.
+	Parse MessageParseType `json:"parse,omitempty"`
+	// Broadcast an existing thread reply to make it visible to everyone in the channel or conversation.
+	ReplyBroadcast bool `json:"reply_broadcast,omitempty"`
+}
+
+type UpdateMessageResponse struct {
+	APIResponse
+
+	Channel   string          `json:"channel"`
+	Timestamp slack.Timestamp `json:"ts"`
+	Text      string          `json:"text"`
+}
+
 type AssistantSetStatusRequest struct {
 	Channel         string          `json:"channel_id"`
 	ThreadTimestamp slack.Timestamp `json:"thread_ts"`
@@ -98,3 +130,126 @@ type AssistantSetSuggestedPromptsRequest struct {
 type AssistantSetSuggestedPromptsResponse struct {
 	APIResponse
 }
+
+type ResponseMetadata struct {
+	// 다음 페이지를 조회할 때 사용하는 커서. 마지막 페이지인 경우 빈 문자열입니다.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// 대화에 게시된 메시지.
+type Message struct {
+	Type            string          `json:"type"`
+	User            string          `json:"user,omitempty"`
+	BotID           string          `json:"bot_id,omitempty"`
+	Text            string          `json:"text"`
+	Timestamp       slack.Timestamp `json:"ts"`
+	ThreadTimestamp slack.Timestamp `json:"thread_ts,omitempty"`
+}
+
+type ConversationsRepliesRequest struct {
+	// Conversation ID to fetch thread from.
+	Channel string
+	// Unique identifier of either a thread's parent message or a message in the thread.
+	Timestamp slack.Timestamp
+	// Paginate through collections of data by setting the cursor parameter
+	// to a next_cursor attribute returned by a previous request's response_metadata.
+	Cursor string
+	// The maximum number of items to return.
+	// Fewer than the requested number of items may be returned, even if the end of the users list hasn't been reached.
+	Limit int
+	// Only messages after this Unix timestamp will be included in results.
+	Oldest slack.Timestamp
+	// Only messages before this Unix timestamp will be included in results.
+	Latest slack.Timestamp
+	// Include messages with oldest or latest timestamps in results.
+	// Ignored unless either timestamp is specified.
+	Inclusive bool
+}
+
+func (r *ConversationsRepliesRequest) values() url.Values {
+	v := url.Values{}
+	v.Set("channel", r.Channel)
+	v.Set("ts", string(r.Timestamp))
+	if r.Cursor != "" {
+		v.Set("cursor", r.Cursor)
+	}
+	if r.Limit > 0 {
+		v.Set("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Oldest != "" {
+		v.Set("oldest", string(r.Oldest))
+	}
+	if r.Latest != "" {
+		v.Set("latest", string(r.Latest))
+	}
+	if r.Inclusive {
+		v.Set("inclusive", "true")
+	}
+	return v
+}
+
+type ConversationsRepliesResponse struct {
+	APIResponse
+
+	Messages         []Message        `json:"messages"`
+	HasMore          bool             `json:"has_more"`
+	ResponseMetadata ResponseMetadata `json:"response_metadata"`
+}
+
+type ReactionsAddRequest struct {
+	// Channel where the message to add reaction to was posted.
+	Channel string `json:"channel"`
+	// Reaction (emoji) name. e.g., "eyes"
+	Name string `json:"name"`
+	// Timestamp of the message to add reaction to.
+	Timestamp slack.Timestamp `json:"timestamp"`
+}
+
+type ReactionsAddResponse struct {
+	APIResponse
+}
+
+type ReactionsRemoveRequest struct {
+	// Channel where the message to remove reaction from was posted.
+	Channel string `json:"channel"`
+	// Reaction (emoji) name. e.g., "eyes"
+	Name string `json:"name"`
+	// Timestamp of the message to remove reaction from.
+	Timestamp slack.Timestamp `json:"timestamp"`
+}
+
+type ReactionsRemoveResponse struct {
+	APIResponse
+}
+
+type ViewsOpenRequest struct {
+	// Exchange a trigger to post to the user.
+	TriggerID string `json:"trigger_id"`
+	// A view payload.
+	View *blockkit.View `json:"view"`
+}
+
+type ViewsOpenResponse struct {
+	APIResponse
+
+	// The view that was opened.
+	View *interactive.View `json:"view"`
+}
+
+type ViewsUpdateRequest struct {
+	// A view object. This must be a JSON-encoded string.
+	View *blockkit.View `json:"view"`
+	// A unique identifier of the view to be updated. Either view_id or external_id is required.
+	ViewID string `json:"view_id,omitempty"`
+	// A unique identifier of the view set by the developer. Either view_id or external_id is required.
+	ExternalID string `json:"external_id,omitempty"`
+	// A string that represents view state to protect against possible race conditions.
+	Hash string `json:"hash,omitempty"`
+}
+
+type ViewsUpdateResponse struct {
+	APIResponse
+
+	// The view that was updated.
+	View *interactive.View `json:"view"`
+}
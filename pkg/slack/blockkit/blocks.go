@@ -5,20 +5,32 @@ import "encoding/json"
 type BlockType string
 
 const (
-	BlockTypeActions BlockType = "actions"
-	BlockTypeDivider BlockType = "divider"
-	BlockTypeHeader  BlockType = "header"
-	BlockTypeSection BlockType = "section"
+	BlockTypeActions  BlockType = "actions"
+	BlockTypeContext  BlockType = "context"
+	BlockTypeDivider  BlockType = "divider"
+	BlockTypeHeader   BlockType = "header"
+	BlockTypeImage    BlockType = "image"
+	BlockTypeInput    BlockType = "input"
+	BlockTypeMarkdown BlockType = "markdown"
+	BlockTypeRichText BlockType = "rich_text"
+	BlockTypeSection  BlockType = "section"
+	BlockTypeTable    BlockType = "table"
 )
 
 type Block struct {
 	Type BlockType `json:"type"`
 	ID   string    `json:"block_id,omitempty"`
 
-	OfActionBlock  *ActionBlock  `json:"-"`
-	OfDividerBlock *DividerBlock `json:"-"`
-	OfHeaderBlock  *HeaderBlock  `json:"-"`
-	OfSectionBlock *SectionBlock `json:"-"`
+	OfActionBlock   *ActionBlock   `json:"-"`
+	OfContextBlock  *ContextBlock  `json:"-"`
+	OfDividerBlock  *DividerBlock  `json:"-"`
+	OfHeaderBlock   *HeaderBlock   `json:"-"`
+	OfImageBlock    *ImageBlock    `json:"-"`
+	OfInputBlock    *InputBlock    `json:"-"`
+	OfMarkdownBlock *MarkdownBlock `json:"-"`
+	OfRichTextBlock *RichTextBlock `json:"-"`
+	OfSectionBlock  *SectionBlock  `json:"-"`
+	OfTableBlock    *TableBlock    `json:"-"`
 }
 
 func NewBlockWithActionBlock(actionBlock *ActionBlock) *Block {
@@ -28,6 +40,13 @@ func NewBlockWithActionBlock(actionBlock *ActionBlock) *Block {
 	}
 }
 
+func NewBlockWithContextBlock(contextBlock *ContextBlock) *Block {
+	return &Block{
+		Type:           BlockTypeContext,
+		OfContextBlock: contextBlock,
+	}
+}
+
 func NewBlockWithDividerBlock() *Block {
 	return &Block{
 		Type:           BlockTypeDivider,
@@ -42,6 +61,34 @@ func NewBlockWithHeaderBlock(headerBlock *HeaderBlock) *Block {
 	}
 }
 
+func NewBlockWithImageBlock(imageBlock *ImageBlock) *Block {
+	return &Block{
+		Type:         BlockTypeImage,
+		OfImageBlock: imageBlock,
+	}
+}
+
+func NewBlockWithInputBlock(inputBlock *InputBlock) *Block {
+	return &Block{
+		Type:         BlockTypeInput,
+		OfInputBlock: inputBlock,
+	}
+}
+
+func NewBlockWithMarkdownBlock(markdownBlock *MarkdownBlock) *Block {
+	return &Block{
+		Type:            BlockTypeMarkdown,
+		OfMarkdownBlock: markdownBlock,
+	}
+}
+
+func NewBlockWithRichTextBlock(richTextBlock *RichTextBlock) *Block {
+	return &Block{
+		Type:            BlockTypeRichText,
+		OfRichTextBlock: richTextBlock,
+	}
+}
+
 func NewBlockWithSectionBlock(sectionBlock *SectionBlock) *Block {
 	return &Block{
 		Type:           BlockTypeSection,
@@ -49,6 +96,13 @@ func NewBlockWithSectionBlock(sectionBlock *SectionBlock) *Block {
 	}
 }
 
+func NewBlockWithTableBlock(tableBlock *TableBlock) *Block {
+	return &Block{
+		Type:         BlockTypeTable,
+		OfTableBlock: tableBlock,
+	}
+}
+
 func (b *Block) MarshalJSON() ([]byte, error) {
 	type Alias Block
 
@@ -59,6 +113,12 @@ func (b *Block) MarshalJSON() ([]byte, error) {
 			Alias
 		}{ActionBlock: *b.OfActionBlock, Alias: (Alias)(*b)}
 		return json.Marshal(raw)
+	case BlockTypeContext:
+		raw := struct {
+			ContextBlock
+			Alias
+		}{ContextBlock: *b.OfContextBlock, Alias: (Alias)(*b)}
+		return json.Marshal(raw)
 	case BlockTypeDivider:
 		raw := struct {
 			DividerBlock
@@ -71,12 +131,42 @@ func (b *Block) MarshalJSON() ([]byte, error) {
 			Alias
 		}{HeaderBlock: *b.OfHeaderBlock, Alias: (Alias)(*b)}
 		return json.Marshal(raw)
+	case BlockTypeImage:
+		raw := struct {
+			ImageBlock
+			Alias
+		}{ImageBlock: *b.OfImageBlock, Alias: (Alias)(*b)}
+		return json.Marshal(raw)
+	case BlockTypeInput:
+		raw := struct {
+			InputBlock
+			Alias
+		}{InputBlock: *b.OfInputBlock, Alias: (Alias)(*b)}
+		return json.Marshal(raw)
+	case BlockTypeMarkdown:
+		raw := struct {
+			MarkdownBlock
+			Alias
+		}{MarkdownBlock: *b.OfMarkdownBlock, Alias: (Alias)(*b)}
+		return json.Marshal(raw)
+	case BlockTypeRichText:
+		raw := struct {
+			RichTextBlock
+			Alias
+		}{RichTextBlock: *b.OfRichTextBlock, Alias: (Alias)(*b)}
+		return json.Marshal(raw)
 	case BlockTypeSection:
 		raw := struct {
 			SectionBlock
 			Alias
 		}{SectionBlock: *b.OfSectionBlock, Alias: (Alias)(*b)}
 		return json.Marshal(raw)
+	case BlockTypeTable:
+		raw := struct {
+			TableBlock
+			Alias
+		}{TableBlock: *b.OfTableBlock, Alias: (Alias)(*b)}
+		return json.Marshal(raw)
 	}
 
 	return nil, nil
@@ -98,6 +188,11 @@ func (b *Block) UnmarshalJSON(data []byte) error {
 		if err := json.Unmarshal(data, b.OfActionBlock); err != nil {
 			return err
 		}
+	case BlockTypeContext:
+		b.OfContextBlock = &ContextBlock{}
+		if err := json.Unmarshal(data, b.OfContextBlock); err != nil {
+			return err
+		}
 	case BlockTypeDivider:
 		b.OfDividerBlock = &DividerBlock{}
 		if err := json.Unmarshal(data, b.OfDividerBlock); err != nil {
@@ -108,11 +203,36 @@ func (b *Block) UnmarshalJSON(data []byte) error {
 		if err := json.Unmarshal(data, b.OfHeaderBlock); err != nil {
 			return err
 		}
+	case BlockTypeImage:
+		b.OfImageBlock = &ImageBlock{}
+		if err := json.Unmarshal(data, b.OfImageBlock); err != nil {
+			return err
+		}
+	case BlockTypeInput:
+		b.OfInputBlock = &InputBlock{}
+		if err := json.Unmarshal(data, b.OfInputBlock); err != nil {
+			return err
+		}
+	case BlockTypeMarkdown:
+		b.OfMarkdownBlock = &MarkdownBlock{}
+		if err := json.Unmarshal(data, b.OfMarkdownBlock); err != nil {
+			return err
+		}
+	case BlockTypeRichText:
+		b.OfRichTextBlock = &RichTextBlock{}
+		if err := json.Unmarshal(data, b.OfRichTextBlock); err != nil {
+			return err
+		}
 	case BlockTypeSection:
 		b.OfSectionBlock = &SectionBlock{}
 		if err := json.Unmarshal(data, b.OfSectionBlock); err != nil {
 			return err
 		}
+	case BlockTypeTable:
+		b.OfTableBlock = &TableBlock{}
+		if err := json.Unmarshal(data, b.OfTableBlock); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -126,6 +246,13 @@ type ActionBlock struct {
 	Elements []*BlockElement `json:"elements"`
 }
 
+// Displays contextual info, which can include both images and text.
+type ContextBlock struct {
+	// An array of text objects. Image elements are not supported yet.
+	// Maximum number of items is 10.
+	Elements []*TextObject `json:"elements"`
+}
+
 // Visually separates pieces of info inside of a message.
 type DividerBlock struct {
 }
@@ -137,6 +264,56 @@ type HeaderBlock struct {
 	Text *TextObject `json:"text"`
 }
 
+// Displays an image.
+type ImageBlock struct {
+	// A plain-text summary of the image. This should not contain any markup.
+	// Maximum length for this field is 2000 characters.
+	AltText string `json:"alt_text"`
+	// The URL for a publicly hosted image.
+	// Maximum length for this field is 3000 characters.
+	ImageURL string `json:"image_url,omitempty"`
+	// An optional title for the image in the form of a text object that can only be of type: plain_text.
+	// Maximum length for the text in this field is 2000 characters.
+	Title *TextObject `json:"title,omitempty"`
+}
+
+// Collects information from users via elements.
+type InputBlock struct {
+	// A label that appears above an input element in the form of a text object
+	// that must have type of plain_text.
+	// Maximum length for the text in this field is 2000 characters.
+	Label *TextObject `json:"label"`
+	// A block element. e.g., plain_text_input, static_select, datepicker
+	Element *BlockElement `json:"element"`
+	// A boolean that indicates whether or not the use of elements in this block
+	// should dispatch a block_actions payload. Defaults to false.
+	DispatchAction bool `json:"dispatch_action,omitempty"`
+	// An optional hint that appears below an input element in a lighter grey.
+	// It must be a text object with a type of plain_text.
+	// Maximum length for the text in this field is 2000 characters.
+	Hint *TextObject `json:"hint,omitempty"`
+	// A boolean that indicates whether the input element may be empty when a user submits the modal.
+	// Defaults to false.
+	Optional bool `json:"optional,omitempty"`
+}
+
+// Displays formatted markdown.
+//
+// Unlike mrkdwn text objects, this block renders standard markdown.
+// It is only available in messages sent by apps.
+type MarkdownBlock struct {
+	// The standard markdown-formatted text.
+	// The cumulative limit for all markdown blocks in a single payload is 12,000 characters.
+	Text string `json:"text"`
+}
+
+// Displays formatted, structured representation of text.
+type RichTextBlock struct {
+	// An array of rich text objects -
+	// rich_text_section, rich_text_list, rich_text_preformatted, and rich_text_quote.
+	Elements []*RichTextElement `json:"elements"`
+}
+
 // Displays text, possibly alongside elements.
 type SectionBlock struct {
 	// The text for the block, in the form of a text object.
@@ -158,3 +335,54 @@ type SectionBlock struct {
 	// users needing to click 'see more' to expand the message.
 	Expand bool `json:"expand,omitempty"`
 }
+
+// Displays structured information in a table.
+// Only one table block is allowed per message.
+type TableBlock struct {
+	// An array consisting of table rows. Maximum 100 rows.
+	// Each row object is an array with a max of 20 table cells.
+	Rows [][]*TableCell `json:"rows"`
+	// An array describing column behavior.
+	// If there are fewer items than columns, the remaining columns use the default settings.
+	ColumnSettings []*TableColumnSetting `json:"column_settings,omitempty"`
+}
+
+type TableCellType string
+
+const (
+	TableCellTypeRawText  TableCellType = "raw_text"
+	TableCellTypeRichText TableCellType = "rich_text"
+)
+
+// A cell of a table block. Either raw text or rich text.
+type TableCell struct {
+	Type TableCellType `json:"type"`
+	// The text of a raw_text cell.
+	Text string `json:"text,omitempty"`
+	// The rich text objects of a rich_text cell.
+	Elements []*RichTextElement `json:"elements,omitempty"`
+}
+
+// NewRawTextCell creates a new raw text table cell.
+func NewRawTextCell(text string) *TableCell {
+	return &TableCell{
+		Type: TableCellTypeRawText,
+		Text: text,
+	}
+}
+
+type TableColumnAlign string
+
+const (
+	TableColumnAlignLeft   TableColumnAlign = "left"
+	TableColumnAlignCenter TableColumnAlign = "center"
+	TableColumnAlignRight  TableColumnAlign = "right"
+)
+
+// Describes the behavior of a table column.
+type TableColumnSetting struct {
+	// The alignment for items in this column. Defaults to left.
+	Align TableColumnAlign `json:"align,omitempty"`
+	// Whether the contents of this column should be wrapped or not. Defaults to false.
+	IsWrapped bool `json:"is_wrapped,omitempty"`
+}
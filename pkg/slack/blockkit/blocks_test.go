@@ -0,0 +1,128 @@
+package blockkit_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+)
+
+// assertJSONEqual는 두 JSON이 필드 순서와 관계없이 같은지 확인합니다.
+func assertJSONEqual(t *testing.T, want, got []byte) {
+	t.Helper()
+
+	var w, g any
+	if err := json.Unmarshal(want, &w); err != nil {
+		t.Fatalf("failed to unmarshal want: %v", err)
+	}
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("failed to unmarshal got: %v", err)
+	}
+	if !reflect.DeepEqual(w, g) {
+		t.Errorf("json mismatch\nwant: %s\ngot:  %s", want, got)
+	}
+}
+
+func TestBlockRoundTrip(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		jsonString string
+		present    func(b *blockkit.Block) bool
+	}{
+		{
+			desc:       "actions",
+			jsonString: `{ "type": "actions", "block_id": "actions1", "elements": [ { "type": "button", "text": { "type": "plain_text", "text": "Approve", "emoji": true }, "action_id": "approve", "value": "yes", "style": "primary" } ] }`,
+			present:    func(b *blockkit.Block) bool { return b.OfActionBlock != nil },
+		},
+		{
+			desc:       "context",
+			jsonString: `{ "type": "context", "elements": [ { "type": "mrkdwn", "text": "*참고한 이슈*" }, { "type": "plain_text", "text": "PAY-1" } ] }`,
+			present:    func(b *blockkit.Block) bool { return b.OfContextBlock != nil },
+		},
+		{
+			desc:       "divider",
+			jsonString: `{ "type": "divider" }`,
+			present:    func(b *blockkit.Block) bool { return b.OfDividerBlock != nil },
+		},
+		{
+			desc:       "header",
+			jsonString: `{ "type": "header", "text": { "type": "plain_text", "text": "Budget Performance" } }`,
+			present:    func(b *blockkit.Block) bool { return b.OfHeaderBlock != nil },
+		},
+		{
+			desc:       "image",
+			jsonString: `{ "type": "image", "image_url": "https://example.com/image.png", "alt_text": "cute cat", "title": { "type": "plain_text", "text": "Please enjoy this photo of a kitten" } }`,
+			present:    func(b *blockkit.Block) bool { return b.OfImageBlock != nil },
+		},
+		{
+			desc:       "input",
+			jsonString: `{ "type": "input", "block_id": "query", "label": { "type": "plain_text", "text": "질문" }, "hint": { "type": "plain_text", "text": "자세히 적어주세요" }, "optional": true, "element": { "type": "plain_text_input", "action_id": "query", "multiline": true } }`,
+			present: func(b *blockkit.Block) bool {
+				return b.OfInputBlock != nil && b.OfInputBlock.Element.OfPlainTextInputElement != nil
+			},
+		},
+		{
+			desc:       "markdown",
+			jsonString: `{ "type": "markdown", "text": "**Lots of information here!!**" }`,
+			present:    func(b *blockkit.Block) bool { return b.OfMarkdownBlock != nil },
+		},
+		{
+			desc:       "rich_text",
+			jsonString: `{ "type": "rich_text", "elements": [ { "type": "rich_text_section", "elements": [ { "type": "text", "text": "Hello ", "style": { "bold": true } }, { "type": "user", "user_id": "U123ABC456" }, { "type": "emoji", "name": "wave" }, { "type": "link", "url": "https://example.com", "text": "link" } ] }, { "type": "rich_text_list", "style": "ordered", "indent": 1, "offset": 2, "border": 1, "elements": [ { "type": "rich_text_section", "elements": [ { "type": "text", "text": "first" } ] } ] }, { "type": "rich_text_preformatted", "elements": [ { "type": "text", "text": "code" } ] }, { "type": "rich_text_quote", "elements": [ { "type": "channel", "channel_id": "C123ABC456" }, { "type": "broadcast", "range": "here" } ] } ] }`,
+			present:    func(b *blockkit.Block) bool { return b.OfRichTextBlock != nil },
+		},
+		{
+			desc:       "section",
+			jsonString: `{ "type": "section", "text": { "type": "mrkdwn", "text": "*답변*" }, "fields": [ { "type": "plain_text", "text": "a" } ], "accessory": { "type": "overflow", "action_id": "more", "options": [ { "text": { "type": "plain_text", "text": "Open" }, "value": "open", "url": "https://example.com" } ] }, "expand": true }`,
+			present: func(b *blockkit.Block) bool {
+				return b.OfSectionBlock != nil && b.OfSectionBlock.Accessory.OfOverflowElement != nil
+			},
+		},
+		{
+			desc:       "table",
+			jsonString: `{ "type": "table", "column_settings": [ { "is_wrapped": true }, { "align": "right" } ], "rows": [ [ { "type": "raw_text", "text": "Header A" }, { "type": "raw_text", "text": "Header B" } ], [ { "type": "raw_text", "text": "Data 1A" }, { "type": "rich_text", "elements": [ { "type": "rich_text_section", "elements": [ { "type": "text", "text": "Data 1B", "style": { "code": true } } ] } ] } ] ] }`,
+			present:    func(b *blockkit.Block) bool { return b.OfTableBlock != nil },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var b blockkit.Block
+			if err := json.Unmarshal([]byte(tc.jsonString), &b); err != nil {
+				t.Fatalf("failed to unmarshal: %v", err)
+			}
+			if string(b.Type) != tc.desc {
+				t.Fatalf("unexpected block type: %v", b.Type)
+			}
+			if !tc.present(&b) {
+				t.Fatalf("missing %s block", tc.desc)
+			}
+			if err := b.Validate(); err != nil {
+				t.Errorf("unexpected validation error: %v", err)
+			}
+
+			data, err := json.Marshal(&b)
+			if err != nil {
+				t.Fatalf("failed to marshal: %v", err)
+			}
+			assertJSONEqual(t, []byte(tc.jsonString), data)
+		})
+	}
+}
+
+func TestRichTextStyle(t *testing.T) {
+	jsonString := `{ "type": "rich_text_list", "style": "bullet", "elements": [ { "type": "rich_text_section", "elements": [ { "type": "text", "text": "item", "style": { "italic": true, "strike": true } } ] } ] }`
+
+	var e blockkit.RichTextElement
+	if err := json.Unmarshal([]byte(jsonString), &e); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	if e.ListStyle != blockkit.RichTextListStyleBullet || e.TextStyle != nil {
+		t.Errorf("unexpected list style: %q, %+v", e.ListStyle, e.TextStyle)
+	}
+	text := e.Elements[0].Elements[0]
+	if text.TextStyle == nil || !text.TextStyle.Italic || !text.TextStyle.Strike || text.ListStyle != "" {
+		t.Errorf("unexpected text style: %+v", text.TextStyle)
+	}
+}
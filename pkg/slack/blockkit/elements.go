@@ -5,13 +5,29 @@ import "encoding/json"
 type BlockElementType string
 
 const (
-	BlockElementTypeButton BlockElementType = "button"
+	BlockElementTypeButton              BlockElementType = "button"
+	BlockElementTypeCheckboxes          BlockElementType = "checkboxes"
+	BlockElementTypeConversationsSelect BlockElementType = "conversations_select"
+	BlockElementTypeDatePicker          BlockElementType = "datepicker"
+	BlockElementTypeOverflow            BlockElementType = "overflow"
+	BlockElementTypePlainTextInput      BlockElementType = "plain_text_input"
+	BlockElementTypeRadioButtons        BlockElementType = "radio_buttons"
+	BlockElementTypeStaticSelect        BlockElementType = "static_select"
+	BlockElementTypeUsersSelect         BlockElementType = "users_select"
 )
 
 type BlockElement struct {
 	Type BlockElementType `json:"type"`
 
-	OfButtonElement *ButtonElement `json:"-"`
+	OfButtonElement              *ButtonElement              `json:"-"`
+	OfCheckboxesElement          *CheckboxesElement          `json:"-"`
+	OfConversationsSelectElement *ConversationsSelectElement `json:"-"`
+	OfDatePickerElement          *DatePickerElement          `json:"-"`
+	OfOverflowElement            *OverflowElement            `json:"-"`
+	OfPlainTextInputElement      *PlainTextInputElement      `json:"-"`
+	OfRadioButtonsElement        *RadioButtonsElement        `json:"-"`
+	OfStaticSelectElement        *StaticSelectElement        `json:"-"`
+	OfUsersSelectElement         *UsersSelectElement         `json:"-"`
 }
 
 func NewBlockElementWithButtonElement(buttonElement *ButtonElement) *BlockElement {
@@ -21,6 +37,62 @@ func NewBlockElementWithButtonElement(buttonElement *ButtonElement) *BlockElemen
 	}
 }
 
+func NewBlockElementWithCheckboxesElement(checkboxesElement *CheckboxesElement) *BlockElement {
+	return &BlockElement{
+		Type:                BlockElementTypeCheckboxes,
+		OfCheckboxesElement: checkboxesElement,
+	}
+}
+
+func NewBlockElementWithConversationsSelectElement(conversationsSelectElement *ConversationsSelectElement) *BlockElement {
+	return &BlockElement{
+		Type:                         BlockElementTypeConversationsSelect,
+		OfConversationsSelectElement: conversationsSelectElement,
+	}
+}
+
+func NewBlockElementWithDatePickerElement(datePickerElement *DatePickerElement) *BlockElement {
+	return &BlockElement{
+		Type:                BlockElementTypeDatePicker,
+		OfDatePickerElement: datePickerElement,
+	}
+}
+
+func NewBlockElementWithOverflowElement(overflowElement *OverflowElement) *BlockElement {
+	return &BlockElement{
+		Type:              BlockElementTypeOverflow,
+		OfOverflowElement: overflowElement,
+	}
+}
+
+func NewBlockElementWithPlainTextInputElement(plainTextInputElement *PlainTextInputElement) *BlockElement {
+	return &BlockElement{
+		Type:                    BlockElementTypePlainTextInput,
+		OfPlainTextInputElement: plainTextInputElement,
+	}
+}
+
+func NewBlockElementWithRadioButtonsElement(radioButtonsElement *RadioButtonsElement) *BlockElement {
+	return &BlockElement{
+		Type:                  BlockElementTypeRadioButtons,
+		OfRadioButtonsElement: radioButtonsElement,
+	}
+}
+
+func NewBlockElementWithStaticSelectElement(staticSelectElement *StaticSelectElement) *BlockElement {
+	return &BlockElement{
+		Type:                  BlockElementTypeStaticSelect,
+		OfStaticSelectElement: staticSelectElement,
+	}
+}
+
+func NewBlockElementWithUsersSelectElement(usersSelectElement *UsersSelectElement) *BlockElement {
+	return &BlockElement{
+		Type:                 BlockElementTypeUsersSelect,
+		OfUsersSelectElement: usersSelectElement,
+	}
+}
+
 func (e *BlockElement) MarshalJSON() ([]byte, error) {
 	type Alias BlockElement
 
@@ -31,6 +103,54 @@ func (e *BlockElement) MarshalJSON() ([]byte, error) {
 			Alias
 		}{ButtonElement: *e.OfButtonElement, Alias: (Alias)(*e)}
 		return json.Marshal(raw)
+	case BlockElementTypeCheckboxes:
+		raw := struct {
+			CheckboxesElement
+			Alias
+		}{CheckboxesElement: *e.OfCheckboxesElement, Alias: (Alias)(*e)}
+		return json.Marshal(raw)
+	case BlockElementTypeConversationsSelect:
+		raw := struct {
+			ConversationsSelectElement
+			Alias
+		}{ConversationsSelectElement: *e.OfConversationsSelectElement, Alias: (Alias)(*e)}
+		return json.Marshal(raw)
+	case BlockElementTypeDatePicker:
+		raw := struct {
+			DatePickerElement
+			Alias
+		}{DatePickerElement: *e.OfDatePickerElement, Alias: (Alias)(*e)}
+		return json.Marshal(raw)
+	case BlockElementTypeOverflow:
+		raw := struct {
+			OverflowElement
+			Alias
+		}{OverflowElement: *e.OfOverflowElement, Alias: (Alias)(*e)}
+		return json.Marshal(raw)
+	case BlockElementTypePlainTextInput:
+		raw := struct {
+			PlainTextInputElement
+			Alias
+		}{PlainTextInputElement: *e.OfPlainTextInputElement, Alias: (Alias)(*e)}
+		return json.Marshal(raw)
+	case BlockElementTypeRadioButtons:
+		raw := struct {
+			RadioButtonsElement
+			Alias
+		}{RadioButtonsElement: *e.OfRadioButtonsElement, Alias: (Alias)(*e)}
+		return json.Marshal(raw)
+	case BlockElementTypeStaticSelect:
+		raw := struct {
+			StaticSelectElement
+			Alias
+		}{StaticSelectElement: *e.OfStaticSelectElement, Alias: (Alias)(*e)}
+		return json.Marshal(raw)
+	case BlockElementTypeUsersSelect:
+		raw := struct {
+			UsersSelectElement
+			Alias
+		}{UsersSelectElement: *e.OfUsersSelectElement, Alias: (Alias)(*e)}
+		return json.Marshal(raw)
 	}
 
 	return nil, nil
@@ -51,6 +171,46 @@ func (e *BlockElement) UnmarshalJSON(data []byte) error {
 		if err := json.Unmarshal(data, e.OfButtonElement); err != nil {
 			return err
 		}
+	case BlockElementTypeCheckboxes:
+		e.OfCheckboxesElement = &CheckboxesElement{}
+		if err := json.Unmarshal(data, e.OfCheckboxesElement); err != nil {
+			return err
+		}
+	case BlockElementTypeConversationsSelect:
+		e.OfConversationsSelectElement = &ConversationsSelectElement{}
+		if err := json.Unmarshal(data, e.OfConversationsSelectElement); err != nil {
+			return err
+		}
+	case BlockElementTypeDatePicker:
+		e.OfDatePickerElement = &DatePickerElement{}
+		if err := json.Unmarshal(data, e.OfDatePickerElement); err != nil {
+			return err
+		}
+	case BlockElementTypeOverflow:
+		e.OfOverflowElement = &OverflowElement{}
+		if err := json.Unmarshal(data, e.OfOverflowElement); err != nil {
+			return err
+		}
+	case BlockElementTypePlainTextInput:
+		e.OfPlainTextInputElement = &PlainTextInputElement{}
+		if err := json.Unmarshal(data, e.OfPlainTextInputElement); err != nil {
+			return err
+		}
+	case BlockElementTypeRadioButtons:
+		e.OfRadioButtonsElement = &RadioButtonsElement{}
+		if err := json.Unmarshal(data, e.OfRadioButtonsElement); err != nil {
+			return err
+		}
+	case BlockElementTypeStaticSelect:
+		e.OfStaticSelectElement = &StaticSelectElement{}
+		if err := json.Unmarshal(data, e.OfStaticSelectElement); err != nil {
+			return err
+		}
+	case BlockElementTypeUsersSelect:
+		e.OfUsersSelectElement = &UsersSelectElement{}
+		if err := json.Unmarshal(data, e.OfUsersSelectElement); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -85,3 +245,169 @@ type ButtonElement struct {
 	// Maximum length is 75 characters.
 	Description *TextObject `json:"accessibility_label,omitempty"`
 }
+
+// Allows users to select a date from a calendar style UI.
+type DatePickerElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The initial date that is selected when the element is loaded.
+	// This should be in the format YYYY-MM-DD.
+	InitialDate string `json:"initial_date,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after a date is selected.
+	Confirm *ConfirmObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A plain_text only text object that defines the placeholder text shown on the datepicker.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+// Allows users to enter freeform text data into a single-line or multi-line field.
+type PlainTextInputElement struct {
+	// An identifier for the input value when the parent modal is submitted.
+	// You can use this when you receive a view_submission payload to identify the value of the input element.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The initial value in the plain-text input when it is loaded.
+	InitialValue string `json:"initial_value,omitempty"`
+	// Indicates whether the input will be a single line (false) or a larger textarea (true).
+	// Defaults to false.
+	Multiline bool `json:"multiline,omitempty"`
+	// The minimum length of input that the user must provide.
+	// If the user provides less, they will receive an error. Maximum value is 3000.
+	MinLength int `json:"min_length,omitempty"`
+	// The maximum length of input that the user can provide.
+	// If the user provides more, they will receive an error.
+	MaxLength int `json:"max_length,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A plain_text only text object that defines the placeholder text shown in the plain-text input.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+// Allows users to choose an option from a static list of options.
+type StaticSelectElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// An array of option objects. Maximum number of options is 100.
+	// If option_groups is specified, this field should not be.
+	Options []*OptionObject `json:"options,omitempty"`
+	// An array of option group objects. Maximum number of option groups is 100.
+	// If options is specified, this field should not be.
+	OptionGroups []*OptionGroupObject `json:"option_groups,omitempty"`
+	// A single option that exactly matches one of the options within options or option_groups.
+	// This option will be selected when the menu initially loads.
+	InitialOption *OptionObject `json:"initial_option,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after a menu item is selected.
+	Confirm *ConfirmObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A plain_text only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+// Allows users to choose multiple items from a list of options.
+type CheckboxesElement struct {
+	// An identifier for the action triggered when the checkbox group is changed.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// An array of option objects. A maximum of 10 options are allowed.
+	Options []*OptionObject `json:"options"`
+	// An array of option objects that exactly matches one or more of the options within options.
+	// These options will be selected when the checkbox group initially loads.
+	InitialOptions []*OptionObject `json:"initial_options,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after clicking one of the checkboxes.
+	Confirm *ConfirmObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+}
+
+// Allows users to choose one conversation from a list of public and private channels,
+// direct messages and group direct messages visible to the current user.
+type ConversationsSelectElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The ID of any valid conversation to be pre-selected when the menu loads.
+	// If default_to_current_conversation is also supplied, initial_conversation will take precedence.
+	InitialConversation string `json:"initial_conversation,omitempty"`
+	// Pre-populates the select menu with the conversation that the user was viewing
+	// when they opened the modal, if available. Default is false.
+	DefaultToCurrentConversation bool `json:"default_to_current_conversation,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after a menu item is selected.
+	Confirm *ConfirmObject `json:"confirm,omitempty"`
+	// When set to true, the view_submission payload from the menu's parent view will contain a response_url.
+	// This response_url can be used for message responses.
+	// The target conversation for the message will be determined by the value of this select menu.
+	// This field only works with menus in input blocks in modals.
+	ResponseURLEnabled bool `json:"response_url_enabled,omitempty"`
+	// A filter object that reduces the list of available conversations using the specified criteria.
+	Filter *ConversationFilterObject `json:"filter,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A plain_text only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
+
+// Allows users to press a button to view a list of options.
+type OverflowElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// An array of up to 5 option objects to display in the menu.
+	Options []*OptionObject `json:"options"`
+	// A confirm object that defines an optional confirmation dialog that appears after a menu item is selected.
+	Confirm *ConfirmObject `json:"confirm,omitempty"`
+}
+
+// Allows users to choose one item from a list of possible options.
+type RadioButtonsElement struct {
+	// An identifier for the action triggered when the radio button group is changed.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// An array of option objects. A maximum of 10 options are allowed.
+	Options []*OptionObject `json:"options"`
+	// An option object that exactly matches one of the options within options.
+	// This option will be selected when the radio button group initially loads.
+	InitialOption *OptionObject `json:"initial_option,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after clicking one of the radio buttons.
+	Confirm *ConfirmObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+}
+
+// Allows users to choose a user from a list of all users in the workspace.
+type UsersSelectElement struct {
+	// An identifier for the action triggered when a menu option is selected.
+	// Should be unique among all other action_ids in the containing block.
+	// Maximum length is 255 characters.
+	ActionID string `json:"action_id,omitempty"`
+	// The user ID of any valid user to be pre-selected when the menu loads.
+	InitialUser string `json:"initial_user,omitempty"`
+	// A confirm object that defines an optional confirmation dialog that appears after a menu item is selected.
+	Confirm *ConfirmObject `json:"confirm,omitempty"`
+	// Indicates whether the element will be set to auto focus within the view object.
+	// Only one element can be set to true. Defaults to false.
+	FocusOnLoad bool `json:"focus_on_load,omitempty"`
+	// A plain_text only text object that defines the placeholder text shown on the menu.
+	// Maximum length for the text in this field is 150 characters.
+	Placeholder *TextObject `json:"placeholder,omitempty"`
+}
@@ -0,0 +1,86 @@
+package blockkit_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+)
+
+func TestBlockElementRoundTrip(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		jsonString string
+		present    func(e *blockkit.BlockElement) bool
+	}{
+		{
+			desc:       "button",
+			jsonString: `{ "type": "button", "text": { "type": "plain_text", "text": "Click Me" }, "action_id": "button", "url": "https://example.com", "value": "click_me_123", "style": "danger", "confirm": { "title": { "type": "plain_text", "text": "Are you sure?" }, "text": { "type": "mrkdwn", "text": "Wouldn't you prefer a good game of _chess_?" }, "confirm": { "type": "plain_text", "text": "Do it" }, "deny": { "type": "plain_text", "text": "Stop" } } }`,
+			present:    func(e *blockkit.BlockElement) bool { return e.OfButtonElement != nil },
+		},
+		{
+			desc:       "checkboxes",
+			jsonString: `{ "type": "checkboxes", "action_id": "checkboxes", "options": [ { "text": { "type": "mrkdwn", "text": "*a*" }, "value": "a", "description": { "type": "plain_text", "text": "first" } }, { "text": { "type": "plain_text", "text": "b" }, "value": "b" } ], "initial_options": [ { "text": { "type": "plain_text", "text": "b" }, "value": "b" } ], "focus_on_load": true }`,
+			present:    func(e *blockkit.BlockElement) bool { return e.OfCheckboxesElement != nil },
+		},
+		{
+			desc:       "conversations_select",
+			jsonString: `{ "type": "conversations_select", "action_id": "conversation", "default_to_current_conversation": true, "response_url_enabled": true, "filter": { "include": [ "public", "private" ], "exclude_bot_users": true }, "placeholder": { "type": "plain_text", "text": "채널 선택" } }`,
+			present:    func(e *blockkit.BlockElement) bool { return e.OfConversationsSelectElement != nil },
+		},
+		{
+			desc:       "datepicker",
+			jsonString: `{ "type": "datepicker", "action_id": "since", "initial_date": "2025-01-31", "placeholder": { "type": "plain_text", "text": "Select a date" } }`,
+			present:    func(e *blockkit.BlockElement) bool { return e.OfDatePickerElement != nil },
+		},
+		{
+			desc:       "overflow",
+			jsonString: `{ "type": "overflow", "action_id": "overflow", "options": [ { "text": { "type": "plain_text", "text": "Jira" }, "value": "jira", "url": "https://jira.example.com" }, { "text": { "type": "plain_text", "text": "삭제" }, "value": "delete" } ] }`,
+			present:    func(e *blockkit.BlockElement) bool { return e.OfOverflowElement != nil },
+		},
+		{
+			desc:       "plain_text_input",
+			jsonString: `{ "type": "plain_text_input", "action_id": "query", "initial_value": "결제", "multiline": true, "min_length": 1, "max_length": 3000, "placeholder": { "type": "plain_text", "text": "질문" } }`,
+			present:    func(e *blockkit.BlockElement) bool { return e.OfPlainTextInputElement != nil },
+		},
+		{
+			desc:       "radio_buttons",
+			jsonString: `{ "type": "radio_buttons", "action_id": "radio", "options": [ { "text": { "type": "plain_text", "text": "공개" }, "value": "in_channel" }, { "text": { "type": "plain_text", "text": "나만 보기" }, "value": "ephemeral" } ], "initial_option": { "text": { "type": "plain_text", "text": "나만 보기" }, "value": "ephemeral" } }`,
+			present:    func(e *blockkit.BlockElement) bool { return e.OfRadioButtonsElement != nil },
+		},
+		{
+			desc:       "static_select",
+			jsonString: `{ "type": "static_select", "action_id": "project", "option_groups": [ { "label": { "type": "plain_text", "text": "결제" }, "options": [ { "text": { "type": "plain_text", "text": "PAY" }, "value": "PAY" } ] } ], "placeholder": { "type": "plain_text", "text": "모든 프로젝트" } }`,
+			present:    func(e *blockkit.BlockElement) bool { return e.OfStaticSelectElement != nil },
+		},
+		{
+			desc:       "users_select",
+			jsonString: `{ "type": "users_select", "action_id": "assignee", "initial_user": "U123ABC456", "placeholder": { "type": "plain_text", "text": "담당자" } }`,
+			present:    func(e *blockkit.BlockElement) bool { return e.OfUsersSelectElement != nil },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var e blockkit.BlockElement
+			if err := json.Unmarshal([]byte(tc.jsonString), &e); err != nil {
+				t.Fatalf("failed to unmarshal: %v", err)
+			}
+			if string(e.Type) != tc.desc {
+				t.Fatalf("unexpected element type: %v", e.Type)
+			}
+			if !tc.present(&e) {
+				t.Fatalf("missing %s element", tc.desc)
+			}
+			if err := e.Validate(); err != nil {
+				t.Errorf("unexpected validation error: %v", err)
+			}
+
+			data, err := json.Marshal(&e)
+			if err != nil {
+				t.Fatalf("failed to marshal: %v", err)
+			}
+			assertJSONEqual(t, []byte(tc.jsonString), data)
+		})
+	}
+}
@@ -63,3 +63,52 @@ type ConfirmObject struct {
 	// Defines the color scheme applied to the confirm button.
 	Style ButtonStyle `json:"style,omitempty"`
 }
+
+// Defines a single item in a number of different selection elements.
+type OptionObject struct {
+	// A text object that defines the text shown in the option on the menu.
+	// Overflow, select, and multi-select menus can only use plain_text objects,
+	// while radio buttons and checkboxes can use mrkdwn text objects.
+	// Maximum length for the text in this field is 75 characters.
+	Text *TextObject `json:"text"`
+	// A unique string value that will be passed to your app when this option is chosen.
+	// Maximum length for this field is 150 characters.
+	Value string `json:"value"`
+	// A plain_text text object that defines a line of descriptive text shown below the text field
+	// beside a single selectable item in a select menu, multi-select menu, checkbox group,
+	// radio button group, or overflow menu.
+	// Maximum length for the text within this field is 75 characters.
+	Description *TextObject `json:"description,omitempty"`
+	// The URL will be loaded in the user's browser when the option is clicked.
+	// The url attribute is only available in overflow menus.
+	// Maximum length for this field is 3000 characters.
+	URL string `json:"url,omitempty"`
+}
+
+// NewOption creates a new option object with plain text.
+func NewOption(text, value string) *OptionObject {
+	return &OptionObject{
+		Text:  NewPlainText(text, false),
+		Value: value,
+	}
+}
+
+// Provides a way to group options in a select or multi-select menu.
+type OptionGroupObject struct {
+	// A plain_text text object that defines the label shown above this group of options.
+	// Maximum length for the text in this field is 75 characters.
+	Label *TextObject `json:"label"`
+	// An array of option objects that belong to this specific group. Maximum of 100 items.
+	Options []*OptionObject `json:"options"`
+}
+
+// Provides a way to filter the list of options in a conversations select menu.
+type ConversationFilterObject struct {
+	// Indicates which type of conversations should be included in the list.
+	// One or more of the following values: im, mpim, private, public.
+	Include []string `json:"include,omitempty"`
+	// Indicates whether to exclude external shared channels from conversation lists. Defaults to false.
+	ExcludeExternalSharedChannels bool `json:"exclude_external_shared_channels,omitempty"`
+	// Indicates whether to exclude bot users from conversation lists. Defaults to false.
+	ExcludeBotUsers bool `json:"exclude_bot_users,omitempty"`
+}
@@ -0,0 +1,130 @@
+package blockkit
+
+import "encoding/json"
+
+type RichTextElementType string
+
+const (
+	// Rich text objects that can be used in a rich_text block.
+	RichTextElementTypeSection      RichTextElementType = "rich_text_section"
+	RichTextElementTypeList         RichTextElementType = "rich_text_list"
+	RichTextElementTypePreformatted RichTextElementType = "rich_text_preformatted"
+	RichTextElementTypeQuote        RichTextElementType = "rich_text_quote"
+
+	// Elements that can be used in a rich text section, preformatted and quote.
+	RichTextElementTypeBroadcast RichTextElementType = "broadcast"
+	RichTextElementTypeChannel   RichTextElementType = "channel"
+	RichTextElementTypeEmoji     RichTextElementType = "emoji"
+	RichTextElementTypeLink      RichTextElementType = "link"
+	RichTextElementTypeText      RichTextElementType = "text"
+	RichTextElementTypeUser      RichTextElementType = "user"
+	RichTextElementTypeUsergroup RichTextElementType = "usergroup"
+)
+
+type RichTextListStyle string
+
+const (
+	RichTextListStyleBullet  RichTextListStyle = "bullet"
+	RichTextListStyleOrdered RichTextListStyle = "ordered"
+)
+
+// An element of a rich_text block.
+//
+// Rich text objects (section, list, preformatted and quote) contain other elements,
+// and the others hold the actual content. Only the fields that match the type are used.
+type RichTextElement struct {
+	Type RichTextElementType `json:"type"`
+
+	// The elements contained in a rich text object.
+	// A rich_text_list contains only rich_text_section elements.
+	Elements []*RichTextElement `json:"elements,omitempty"`
+	// The type of list of a rich_text_list.
+	ListStyle RichTextListStyle `json:"-"`
+	// Number of pixels to indent the list of a rich_text_list.
+	Indent int `json:"indent,omitempty"`
+	// Number to offset the first number in an ordered rich_text_list.
+	Offset int `json:"offset,omitempty"`
+	// Number of pixels of border thickness of a rich_text_list, rich_text_preformatted or rich_text_quote.
+	Border int `json:"border,omitempty"`
+
+	// The text of a text element, or the text shown for a link element.
+	Text string `json:"text,omitempty"`
+	// The link URL of a link element.
+	URL string `json:"url,omitempty"`
+	// The name of the emoji of an emoji element. e.g., "wave"
+	Name string `json:"name,omitempty"`
+	// The ID of the mentioned user of a user element.
+	UserID string `json:"user_id,omitempty"`
+	// The ID of the mentioned channel of a channel element.
+	ChannelID string `json:"channel_id,omitempty"`
+	// The ID of the mentioned user group of a usergroup element.
+	UsergroupID string `json:"usergroup_id,omitempty"`
+	// The range of the broadcast of a broadcast element. e.g., "here", "channel", "everyone"
+	Range string `json:"range,omitempty"`
+	// The style of a text, link, emoji, user, channel or usergroup element.
+	TextStyle *RichTextStyle `json:"-"`
+}
+
+// The style of a rich text element.
+type RichTextStyle struct {
+	Bold   bool `json:"bold,omitempty"`
+	Italic bool `json:"italic,omitempty"`
+	Strike bool `json:"strike,omitempty"`
+	Code   bool `json:"code,omitempty"`
+}
+
+// NewRichTextSection creates a new rich text section with the given elements.
+func NewRichTextSection(elements ...*RichTextElement) *RichTextElement {
+	return &RichTextElement{
+		Type:     RichTextElementTypeSection,
+		Elements: elements,
+	}
+}
+
+// NewRichText creates a new rich text element with the given style.
+func NewRichText(text string, style *RichTextStyle) *RichTextElement {
+	return &RichTextElement{
+		Type:      RichTextElementTypeText,
+		Text:      text,
+		TextStyle: style,
+	}
+}
+
+// The style field is a string for lists and an object for the others.
+func (e *RichTextElement) MarshalJSON() ([]byte, error) {
+	type Alias RichTextElement
+
+	raw := struct {
+		Alias
+		Style any `json:"style,omitempty"`
+	}{Alias: (Alias)(*e)}
+	switch {
+	case e.Type == RichTextElementTypeList && e.ListStyle != "":
+		raw.Style = e.ListStyle
+	case e.TextStyle != nil:
+		raw.Style = e.TextStyle
+	}
+	return json.Marshal(raw)
+}
+
+func (e *RichTextElement) UnmarshalJSON(data []byte) error {
+	type alias RichTextElement
+
+	raw := &struct {
+		alias
+		Style json.RawMessage `json:"style,omitempty"`
+	}{}
+	if err := json.Unmarshal(data, raw); err != nil {
+		return err
+	}
+
+	*e = RichTextElement(raw.alias)
+	if len(raw.Style) == 0 {
+		return nil
+	}
+	if e.Type == RichTextElementTypeList {
+		return json.Unmarshal(raw.Style, &e.ListStyle)
+	}
+	e.TextStyle = &RichTextStyle{}
+	return json.Unmarshal(raw.Style, e.TextStyle)
+}
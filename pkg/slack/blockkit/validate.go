@@ -0,0 +1,367 @@
+package blockkit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+// Limits documented by Slack.
+const (
+	// Maximum number of blocks in a message.
+	MaxMessageBlocks = 50
+	// Maximum number of blocks in a modal or Home tab.
+	MaxViewBlocks = 100
+	// Maximum cumulative length of all markdown blocks in a single payload.
+	MaxMarkdownLength = 12000
+)
+
+// ValidateBlocks checks that the blocks of a message satisfy the limits documented by Slack,
+// such as the number of blocks, text lengths and the uniqueness of block_id and action_id.
+// All violations are joined into the returned error.
+func ValidateBlocks(blocks []*Block) error {
+	v := &validator{}
+	v.blocks("blocks", blocks, MaxMessageBlocks)
+
+	tables := 0
+	for _, b := range blocks {
+		if b != nil && b.Type == BlockTypeTable {
+			tables++
+		}
+	}
+	v.check(tables <= 1, "blocks", "must contain at most one table block, got %d", tables)
+
+	return v.err()
+}
+
+// Validate checks that the view satisfies the limits documented by Slack.
+// A nil view is reported as an error.
+func (view *View) Validate() error {
+	v := &validator{}
+	if view == nil {
+		v.check(false, "view", "is required")
+		return v.err()
+	}
+	v.check(view.Type != "", "view.type", "is required")
+	if view.Type == ViewTypeModal {
+		v.check(view.Title != nil, "view.title", "is required for modals")
+		v.text("view.title", view.Title, 24, true)
+		v.text("view.submit", view.Submit, 24, true)
+		v.text("view.close", view.Close, 24, true)
+
+		hasInput := false
+		for _, b := range view.Blocks {
+			if b != nil && b.Type == BlockTypeInput {
+				hasInput = true
+			}
+		}
+		v.check(!hasInput || view.Submit != nil, "view.submit", "is required when the view contains input blocks")
+	}
+	v.length("view.private_metadata", view.PrivateMetadata, 3000)
+	v.length("view.callback_id", view.CallbackID, 255)
+	v.length("view.external_id", view.ExternalID, 255)
+	v.blocks("view.blocks", view.Blocks, MaxViewBlocks)
+	return v.err()
+}
+
+// Validate checks that the block satisfies the limits documented by Slack.
+func (b *Block) Validate() error {
+	v := &validator{}
+	v.block("block", b)
+	return v.err()
+}
+
+// Validate checks that the element satisfies the limits documented by Slack.
+func (e *BlockElement) Validate() error {
+	v := &validator{}
+	v.element("element", e)
+	return v.err()
+}
+
+// validator collects violations with the path of the invalid field.
+type validator struct {
+	errs []error
+
+	markdownLength int
+}
+
+func (v *validator) err() error {
+	return errors.Join(v.errs...)
+}
+
+func (v *validator) check(ok bool, path, format string, args ...any) {
+	if !ok {
+		v.errs = append(v.errs, fmt.Errorf("%s: "+format, append([]any{path}, args...)...))
+	}
+}
+
+func (v *validator) length(path, s string, max int) {
+	n := utf8.RuneCountInString(s)
+	v.check(n <= max, path, "must be at most %d characters, got %d", max, n)
+}
+
+func (v *validator) count(path string, n, min, max int) {
+	v.check(n >= min, path, "must have at least %d items, got %d", min, n)
+	v.check(n <= max, path, "must have at most %d items, got %d", max, n)
+}
+
+// text checks an optional text object. Use check for required text objects.
+func (v *validator) text(path string, t *TextObject, max int, plainOnly bool) {
+	if t == nil {
+		return
+	}
+	v.check(t.Type == PlainText || t.Type == Markdown, path+".type", "must be plain_text or mrkdwn, got %q", t.Type)
+	v.check(!plainOnly || t.Type == PlainText, path+".type", "must be plain_text")
+	v.check(t.Text != "", path+".text", "must not be empty")
+	v.length(path+".text", t.Text, max)
+}
+
+func (v *validator) blocks(path string, blocks []*Block, max int) {
+	v.count(path, len(blocks), 0, max)
+
+	blockIDs := make(map[string]bool, len(blocks))
+	for i, b := range blocks {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		if b == nil {
+			v.check(false, p, "must not be null")
+			continue
+		}
+		if b.ID != "" {
+			v.check(!blockIDs[b.ID], p+".block_id", "must be unique, %q is duplicated", b.ID)
+			blockIDs[b.ID] = true
+		}
+		v.block(p, b)
+	}
+	v.check(v.markdownLength <= MaxMarkdownLength, path,
+		"markdown blocks must be at most %d characters in total, got %d", MaxMarkdownLength, v.markdownLength)
+}
+
+func (v *validator) block(path string, b *Block) {
+	v.length(path+".block_id", b.ID, 255)
+
+	switch b.Type {
+	case BlockTypeActions:
+		if v.present(path, b.OfActionBlock != nil) {
+			v.count(path+".elements", len(b.OfActionBlock.Elements), 1, 25)
+			v.elements(path+".elements", b.OfActionBlock.Elements)
+		}
+	case BlockTypeContext:
+		if v.present(path, b.OfContextBlock != nil) {
+			v.count(path+".elements", len(b.OfContextBlock.Elements), 1, 10)
+			for i, t := range b.OfContextBlock.Elements {
+				v.text(fmt.Sprintf("%s.elements[%d]", path, i), t, 3000, false)
+			}
+		}
+	case BlockTypeDivider:
+	case BlockTypeHeader:
+		if v.present(path, b.OfHeaderBlock != nil) {
+			v.check(b.OfHeaderBlock.Text != nil, path+".text", "is required")
+			v.text(path+".text", b.OfHeaderBlock.Text, 150, true)
+		}
+	case BlockTypeImage:
+		if v.present(path, b.OfImageBlock != nil) {
+			v.check(b.OfImageBlock.AltText != "", path+".alt_text", "is required")
+			v.length(path+".alt_text", b.OfImageBlock.AltText, 2000)
+			v.check(b.OfImageBlock.ImageURL != "", path+".image_url", "is required")
+			v.length(path+".image_url", b.OfImageBlock.ImageURL, 3000)
+			v.text(path+".title", b.OfImageBlock.Title, 2000, true)
+		}
+	case BlockTypeInput:
+		if v.present(path, b.OfInputBlock != nil) {
+			v.check(b.OfInputBlock.Label != nil, path+".label", "is required")
+			v.text(path+".label", b.OfInputBlock.Label, 2000, true)
+			v.text(path+".hint", b.OfInputBlock.Hint, 2000, true)
+			v.check(b.OfInputBlock.Element != nil, path+".element", "is required")
+			if e := b.OfInputBlock.Element; e != nil {
+				v.check(e.Type != BlockElementTypeButton && e.Type != BlockElementTypeOverflow,
+					path+".element.type", "%q is not supported in input blocks", e.Type)
+				v.element(path+".element", e)
+			}
+		}
+	case BlockTypeMarkdown:
+		if v.present(path, b.OfMarkdownBlock != nil) {
+			v.check(b.OfMarkdownBlock.Text != "", path+".text", "must not be empty")
+			v.markdownLength += utf8.RuneCountInString(b.OfMarkdownBlock.Text)
+		}
+	case BlockTypeRichText:
+		if v.present(path, b.OfRichTextBlock != nil) {
+			v.check(len(b.OfRichTextBlock.Elements) > 0, path+".elements", "must not be empty")
+		}
+	case BlockTypeSection:
+		if v.present(path, b.OfSectionBlock != nil) {
+			s := b.OfSectionBlock
+			v.check(s.Text != nil || len(s.Fields) > 0, path, "text or fields is required")
+			v.text(path+".text", s.Text, 3000, false)
+			v.count(path+".fields", len(s.Fields), 0, 10)
+			for i := range s.Fields {
+				v.text(fmt.Sprintf("%s.fields[%d]", path, i), &s.Fields[i], 2000, false)
+			}
+			if s.Accessory != nil {
+				v.element(path+".accessory", s.Accessory)
+			}
+		}
+	case BlockTypeTable:
+		if v.present(path, b.OfTableBlock != nil) {
+			v.count(path+".rows", len(b.OfTableBlock.Rows), 1, 100)
+			for i, row := range b.OfTableBlock.Rows {
+				v.count(fmt.Sprintf("%s.rows[%d]", path, i), len(row), 1, 20)
+			}
+		}
+	default:
+		v.check(false, path+".type", "unsupported block type %q", b.Type)
+	}
+}
+
+// present checks that the block or element has the value matching its type.
+func (v *validator) present(path string, ok bool) bool {
+	v.check(ok, path, "value for the type is missing")
+	return ok
+}
+
+// elements checks the elements of a block and that their action_ids are unique within the block.
+func (v *validator) elements(path string, elements []*BlockElement) {
+	actionIDs := make(map[string]bool, len(elements))
+	for i, e := range elements {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		if e == nil {
+			v.check(false, p, "must not be null")
+			continue
+		}
+		if id := e.actionID(); id != "" {
+			v.check(!actionIDs[id], p+".action_id", "must be unique within the block, %q is duplicated", id)
+			actionIDs[id] = true
+		}
+		v.element(p, e)
+	}
+}
+
+func (e *BlockElement) actionID() string {
+	switch {
+	case e.OfButtonElement != nil:
+		return e.OfButtonElement.ActionID
+	case e.OfCheckboxesElement != nil:
+		return e.OfCheckboxesElement.ActionID
+	case e.OfConversationsSelectElement != nil:
+		return e.OfConversationsSelectElement.ActionID
+	case e.OfDatePickerElement != nil:
+		return e.OfDatePickerElement.ActionID
+	case e.OfOverflowElement != nil:
+		return e.OfOverflowElement.ActionID
+	case e.OfPlainTextInputElement != nil:
+		return e.OfPlainTextInputElement.ActionID
+	case e.OfRadioButtonsElement != nil:
+		return e.OfRadioButtonsElement.ActionID
+	case e.OfStaticSelectElement != nil:
+		return e.OfStaticSelectElement.ActionID
+	case e.OfUsersSelectElement != nil:
+		return e.OfUsersSelectElement.ActionID
+	}
+	return ""
+}
+
+func (v *validator) element(path string, e *BlockElement) {
+	v.length(path+".action_id", e.actionID(), 255)
+
+	switch e.Type {
+	case BlockElementTypeButton:
+		if v.present(path, e.OfButtonElement != nil) {
+			b := e.OfButtonElement
+			v.check(b.Text != nil, path+".text", "is required")
+			v.text(path+".text", b.Text, 75, true)
+			v.length(path+".url", b.URL, 3000)
+			v.length(path+".value", b.Value, 2000)
+			v.confirm(path+".confirm", b.Confirm)
+			v.text(path+".accessibility_label", b.Description, 75, false)
+		}
+	case BlockElementTypeCheckboxes:
+		if v.present(path, e.OfCheckboxesElement != nil) {
+			v.count(path+".options", len(e.OfCheckboxesElement.Options), 1, 10)
+			v.options(path+".options", e.OfCheckboxesElement.Options, false)
+			v.confirm(path+".confirm", e.OfCheckboxesElement.Confirm)
+		}
+	case BlockElementTypeConversationsSelect:
+		if v.present(path, e.OfConversationsSelectElement != nil) {
+			v.text(path+".placeholder", e.OfConversationsSelectElement.Placeholder, 150, true)
+			v.confirm(path+".confirm", e.OfConversationsSelectElement.Confirm)
+		}
+	case BlockElementTypeDatePicker:
+		if v.present(path, e.OfDatePickerElement != nil) {
+			if d := e.OfDatePickerElement.InitialDate; d != "" {
+				_, err := time.Parse(time.DateOnly, d)
+				v.check(err == nil, path+".initial_date", "must be in the format YYYY-MM-DD, got %q", d)
+			}
+			v.text(path+".placeholder", e.OfDatePickerElement.Placeholder, 150, true)
+			v.confirm(path+".confirm", e.OfDatePickerElement.Confirm)
+		}
+	case BlockElementTypeOverflow:
+		if v.present(path, e.OfOverflowElement != nil) {
+			v.count(path+".options", len(e.OfOverflowElement.Options), 1, 5)
+			v.options(path+".options", e.OfOverflowElement.Options, true)
+			v.confirm(path+".confirm", e.OfOverflowElement.Confirm)
+		}
+	case BlockElementTypePlainTextInput:
+		if v.present(path, e.OfPlainTextInputElement != nil) {
+			p := e.OfPlainTextInputElement
+			v.check(p.MinLength >= 0 && p.MinLength <= 3000, path+".min_length", "must be between 0 and 3000, got %d", p.MinLength)
+			v.check(p.MaxLength == 0 || p.MaxLength >= p.MinLength, path+".max_length",
+				"must not be less than min_length %d, got %d", p.MinLength, p.MaxLength)
+			v.text(path+".placeholder", p.Placeholder, 150, true)
+		}
+	case BlockElementTypeRadioButtons:
+		if v.present(path, e.OfRadioButtonsElement != nil) {
+			v.count(path+".options", len(e.OfRadioButtonsElement.Options), 1, 10)
+			v.options(path+".options", e.OfRadioButtonsElement.Options, false)
+			v.confirm(path+".confirm", e.OfRadioButtonsElement.Confirm)
+		}
+	case BlockElementTypeStaticSelect:
+		if v.present(path, e.OfStaticSelectElement != nil) {
+			s := e.OfStaticSelectElement
+			v.check((len(s.Options) > 0) != (len(s.OptionGroups) > 0), path, "exactly one of options or option_groups is required")
+			v.count(path+".options", len(s.Options), 0, 100)
+			v.options(path+".options", s.Options, true)
+			v.count(path+".option_groups", len(s.OptionGroups), 0, 100)
+			for i, g := range s.OptionGroups {
+				p := fmt.Sprintf("%s.option_groups[%d]", path, i)
+				v.check(g.Label != nil, p+".label", "is required")
+				v.text(p+".label", g.Label, 75, true)
+				v.count(p+".options", len(g.Options), 1, 100)
+				v.options(p+".options", g.Options, true)
+			}
+			v.text(path+".placeholder", s.Placeholder, 150, true)
+			v.confirm(path+".confirm", s.Confirm)
+		}
+	case BlockElementTypeUsersSelect:
+		if v.present(path, e.OfUsersSelectElement != nil) {
+			v.text(path+".placeholder", e.OfUsersSelectElement.Placeholder, 150, true)
+			v.confirm(path+".confirm", e.OfUsersSelectElement.Confirm)
+		}
+	default:
+		v.check(false, path+".type", "unsupported element type %q", e.Type)
+	}
+}
+
+func (v *validator) options(path string, options []*OptionObject, plainOnly bool) {
+	for i, o := range options {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		if o == nil {
+			v.check(false, p, "must not be null")
+			continue
+		}
+		v.check(o.Text != nil, p+".text", "is required")
+		v.text(p+".text", o.Text, 75, plainOnly)
+		v.length(p+".value", o.Value, 150)
+		v.text(p+".description", o.Description, 75, true)
+		v.length(p+".url", o.URL, 3000)
+	}
+}
+
+func (v *validator) confirm(path string, c *ConfirmObject) {
+	if c == nil {
+		return
+	}
+	v.text(path+".title", c.Title, 100, true)
+	v.text(path+".text", c.Text, 300, false)
+	v.text(path+".confirm", c.Confirm, 30, true)
+	v.text(path+".deny", c.Deny, 30, true)
+}
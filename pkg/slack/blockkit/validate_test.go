@@ -0,0 +1,221 @@
+package blockkit_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+)
+
+func section(text string) *blockkit.Block {
+	return blockkit.NewBlockWithSectionBlock(&blockkit.SectionBlock{
+		Text: blockkit.NewMarkdownText(text, false),
+	})
+}
+
+func button(actionID, text string) *blockkit.BlockElement {
+	return blockkit.NewBlockElementWithButtonElement(&blockkit.ButtonElement{
+		Text:     blockkit.NewPlainText(text, false),
+		ActionID: actionID,
+	})
+}
+
+func TestValidateBlocks(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		blocks  []*blockkit.Block
+		wantErr string
+	}{
+		{
+			desc: "valid",
+			blocks: []*blockkit.Block{
+				blockkit.NewBlockWithHeaderBlock(&blockkit.HeaderBlock{Text: blockkit.NewPlainText("답변", false)}),
+				section(strings.Repeat("가", 3000)),
+				blockkit.NewBlockWithDividerBlock(),
+				blockkit.NewBlockWithActionBlock(&blockkit.ActionBlock{
+					Elements: []*blockkit.BlockElement{button("good", "👍"), button("bad", "👎")},
+				}),
+			},
+		},
+		{
+			desc: "too many blocks",
+			blocks: func() []*blockkit.Block {
+				b := make([]*blockkit.Block, 51)
+				for i := range b {
+					b[i] = blockkit.NewBlockWithDividerBlock()
+				}
+				return b
+			}(),
+			wantErr: "blocks: must have at most 50 items",
+		},
+		{
+			desc:    "section text too long",
+			blocks:  []*blockkit.Block{section(strings.Repeat("가", 3001))},
+			wantErr: "blocks[0].text.text: must be at most 3000 characters, got 3001",
+		},
+		{
+			desc:    "empty section",
+			blocks:  []*blockkit.Block{blockkit.NewBlockWithSectionBlock(&blockkit.SectionBlock{})},
+			wantErr: "blocks[0]: text or fields is required",
+		},
+		{
+			desc:    "header must be plain text",
+			blocks:  []*blockkit.Block{blockkit.NewBlockWithHeaderBlock(&blockkit.HeaderBlock{Text: blockkit.NewMarkdownText("*답변*", false)})},
+			wantErr: "blocks[0].text.type: must be plain_text",
+		},
+		{
+			desc: "duplicated action_id",
+			blocks: []*blockkit.Block{blockkit.NewBlockWithActionBlock(&blockkit.ActionBlock{
+				Elements: []*blockkit.BlockElement{button("feedback", "👍"), button("feedback", "👎")},
+			})},
+			wantErr: `blocks[0].elements[1].action_id: must be unique within the block, "feedback" is duplicated`,
+		},
+		{
+			desc: "duplicated block_id",
+			blocks: func() []*blockkit.Block {
+				a, b := section("a"), section("b")
+				a.ID, b.ID = "answer", "answer"
+				return []*blockkit.Block{a, b}
+			}(),
+			wantErr: `blocks[1].block_id: must be unique, "answer" is duplicated`,
+		},
+		{
+			desc: "button text too long",
+			blocks: []*blockkit.Block{blockkit.NewBlockWithActionBlock(&blockkit.ActionBlock{
+				Elements: []*blockkit.BlockElement{button("long", strings.Repeat("a", 76))},
+			})},
+			wantErr: "blocks[0].elements[0].text.text: must be at most 75 characters, got 76",
+		},
+		{
+			desc: "too many overflow options",
+			blocks: []*blockkit.Block{blockkit.NewBlockWithActionBlock(&blockkit.ActionBlock{
+				Elements: []*blockkit.BlockElement{blockkit.NewBlockElementWithOverflowElement(&blockkit.OverflowElement{
+					ActionID: "more",
+					Options: []*blockkit.OptionObject{
+						blockkit.NewOption("1", "1"), blockkit.NewOption("2", "2"), blockkit.NewOption("3", "3"),
+						blockkit.NewOption("4", "4"), blockkit.NewOption("5", "5"), blockkit.NewOption("6", "6"),
+					},
+				})},
+			})},
+			wantErr: "blocks[0].elements[0].options: must have at most 5 items, got 6",
+		},
+		{
+			desc:    "markdown blocks too long in total",
+			blocks:  []*blockkit.Block{blockkit.NewBlockWithMarkdownBlock(&blockkit.MarkdownBlock{Text: strings.Repeat("a", 6000)}), blockkit.NewBlockWithMarkdownBlock(&blockkit.MarkdownBlock{Text: strings.Repeat("a", 6001)})},
+			wantErr: "markdown blocks must be at most 12000 characters in total, got 12001",
+		},
+		{
+			desc: "more than one table",
+			blocks: []*blockkit.Block{
+				blockkit.NewBlockWithTableBlock(&blockkit.TableBlock{Rows: [][]*blockkit.TableCell{{blockkit.NewRawTextCell("a")}}}),
+				blockkit.NewBlockWithTableBlock(&blockkit.TableBlock{Rows: [][]*blockkit.TableCell{{blockkit.NewRawTextCell("b")}}}),
+			},
+			wantErr: "blocks: must contain at most one table block, got 2",
+		},
+		{
+			desc:    "missing value for type",
+			blocks:  []*blockkit.Block{{Type: blockkit.BlockTypeSection}},
+			wantErr: "blocks[0]: value for the type is missing",
+		},
+		{
+			desc: "invalid initial date",
+			blocks: []*blockkit.Block{blockkit.NewBlockWithActionBlock(&blockkit.ActionBlock{
+				Elements: []*blockkit.BlockElement{blockkit.NewBlockElementWithDatePickerElement(&blockkit.DatePickerElement{
+					ActionID:    "since",
+					InitialDate: "2025/01/01",
+				})},
+			})},
+			wantErr: `blocks[0].elements[0].initial_date: must be in the format YYYY-MM-DD, got "2025/01/01"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := blockkit.ValidateBlocks(tc.blocks)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestViewValidate(t *testing.T) {
+	input := blockkit.NewBlockWithInputBlock(&blockkit.InputBlock{
+		Label: blockkit.NewPlainText("질문", false),
+		Element: blockkit.NewBlockElementWithPlainTextInputElement(&blockkit.PlainTextInputElement{
+			ActionID: "query",
+		}),
+	})
+
+	testCases := []struct {
+		desc    string
+		view    *blockkit.View
+		wantErr string
+	}{
+		{
+			desc: "valid",
+			view: &blockkit.View{
+				Type:   blockkit.ViewTypeModal,
+				Title:  blockkit.NewPlainText("상세 검색", false),
+				Submit: blockkit.NewPlainText("검색", false),
+				Blocks: []*blockkit.Block{input},
+			},
+		},
+		{
+			desc:    "nil view",
+			view:    nil,
+			wantErr: "view: is required",
+		},
+		{
+			desc: "missing submit",
+			view: &blockkit.View{
+				Type:   blockkit.ViewTypeModal,
+				Title:  blockkit.NewPlainText("상세 검색", false),
+				Blocks: []*blockkit.Block{input},
+			},
+			wantErr: "view.submit: is required when the view contains input blocks",
+		},
+		{
+			desc: "title too long",
+			view: &blockkit.View{
+				Type:  blockkit.ViewTypeModal,
+				Title: blockkit.NewPlainText(strings.Repeat("a", 25), false),
+			},
+			wantErr: "view.title.text: must be at most 24 characters, got 25",
+		},
+		{
+			desc: "button in input block",
+			view: &blockkit.View{
+				Type:   blockkit.ViewTypeModal,
+				Title:  blockkit.NewPlainText("상세 검색", false),
+				Submit: blockkit.NewPlainText("검색", false),
+				Blocks: []*blockkit.Block{blockkit.NewBlockWithInputBlock(&blockkit.InputBlock{
+					Label:   blockkit.NewPlainText("버튼", false),
+					Element: button("click", "click"),
+				})},
+			},
+			wantErr: `view.blocks[0].element.type: "button" is not supported in input blocks`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.view.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -2,66 +2,262 @@ package bot
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"log/slog"
-	"net"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/gorilla/websocket"
 
+	"github.com/joyfuldevs/project-lumos/pkg/retry"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
 	"github.com/joyfuldevs/project-lumos/pkg/slack/event"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/eventsapi"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/slashcommand"
 )
 
 type Bot struct {
+	options *botOptions
 	handler EventHandler
+
+	duplicates atomic.Int64
+}
+
+func NewBot(handler EventHandler, opts ...Option) *Bot {
+	options := defaultBotOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.dedupStore == nil {
+		options.dedupStore = NewMemoryDedupStore(10 * time.Minute)
+	}
+	return &Bot{
+		options: &options,
+		handler: handler,
+	}
 }
 
-func NewBot(handler EventHandler) *Bot {
-	return &Bot{handler: handler}
+// ConnectionOpener는 소켓 모드 연결에 사용할 WebSocket URL을 발급합니다.
+// api.Client가 이 인터페이스를 구현합니다.
+type ConnectionOpener interface {
+	OpenConnection(ctx context.Context) (*api.OpenConnectionResponse, error)
 }
 
+// Run은 주어진 URL로 한 번만 연결하고, 연결이 끊어지거나 ctx가 취소되면 반환합니다.
+// 연결이 끊어졌을 때 다시 연결하려면 Serve를 사용합니다.
+//
+// ctx가 취소되면 더 이상 이벤트를 읽지 않고, 처리 중인 이벤트를 모두 마친 뒤
+// 연결을 닫습니다.
 func (b *Bot) Run(ctx context.Context, url string) error {
-	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
 	if err != nil {
 		return err
 	}
+	c := newConnection(conn, b.options.pingInterval, b.options.pongWait)
+
+	handlerCtx, cancelHandlers := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelHandlers()
+
+	d := newDispatcher(b.options.workers, b.options.queueSize)
+
+	b.serveConn(ctx, handlerCtx, c, d, nil)
+	b.drain(d, cancelHandlers)
+	c.close()
+
+	return nil
+}
+
+// Serve는 연결이 끊어지거나 Slack이 disconnect 이벤트를 보내면
+// 새 URL을 발급받아 다시 연결하며, ctx가 취소될 때까지 이벤트를 처리합니다.
+// 재시도 횟수를 모두 소진해도 연결하지 못하면 에러를 반환합니다.
+//
+// 종료할 때는 Run과 마찬가지로 처리 중인 이벤트를 모두 마친 뒤 연결을 닫습니다.
+func (b *Bot) Serve(ctx context.Context, opener ConnectionOpener) error {
+	handlerCtx, cancelHandlers := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelHandlers()
+
+	d := newDispatcher(b.options.workers, b.options.queueSize)
+
+	connCtx, connCancel := context.WithCancel(ctx)
+
+	var (
+		// 연결마다 실행되는 고루틴. 연결을 닫을 때까지 기다린다.
+		wg sync.WaitGroup
+		// 이벤트를 읽어 dispatcher에 넘기는 serveConn. 대기열을 닫기 전에 모두 끝나야 한다.
+		readers sync.WaitGroup
+		active  int
+		closed  = make(chan struct{})
+		drained = make(chan struct{})
+		refresh chan struct{}
+	)
+	if b.options.warmStandby {
+		refresh = make(chan struct{}, 1)
+	}
+
 	defer func() {
-		if err := conn.Close(); err != nil {
-			slog.Warn("failed to close websocket connection", slog.Any("error", err))
-		}
+		connCancel()
+		// 이벤트를 읽는 중인 serveConn이 닫힌 대기열에 작업을 넣지 않도록
+		// 모두 반환한 뒤에 대기열을 닫는다.
+		readers.Wait()
+		b.drain(d, cancelHandlers)
+		close(drained)
+		wg.Wait()
 	}()
 
-	slog.Info("websocket connection established")
+	connect := func() error {
+		conn, err := b.connect(connCtx, opener)
+		if err != nil {
+			return err
+		}
+		c := newConnection(conn, b.options.pingInterval, b.options.pongWait)
 
-	connCtx, connCancel := context.WithCancel(ctx)
-	defer connCancel()
+		active++
+		wg.Add(1)
+		readers.Add(1)
+		go func() {
+			defer wg.Done()
+			b.serveConn(connCtx, handlerCtx, c, d, refresh)
+			readers.Done()
+			if connCtx.Err() != nil {
+				// 종료 중이면 처리 중인 이벤트를 모두 마친 뒤에 연결을 닫는다.
+				<-drained
+				c.close()
+				return
+			}
+			c.close()
+			select {
+			case closed <- struct{}{}:
+			case <-connCtx.Done():
+			}
+		}()
+		return nil
+	}
+
+	if err := connect(); err != nil {
+		return err
+	}
 
 	for {
 		select {
-		case <-connCtx.Done():
+		case <-ctx.Done():
 			return nil
-		case e, ok := <-receiveEvent(conn):
+		case <-refresh:
+			// 기존 연결이 닫히기 전에 새 연결을 미리 열어 둔다.
+			// 두 연결로 같은 이벤트가 전달되더라도 중복 제거로 걸러진다.
+			slog.Info("opening standby websocket connection")
+			if err := connect(); err != nil {
+				return err
+			}
+		case <-closed:
+			active--
+			if active > 0 {
+				continue
+			}
+			slog.Info("websocket connection closed, reconnecting")
+			if err := connect(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// connect는 새 URL을 발급받아 연결하며, 실패하면 백오프를 두고 재시도합니다.
+func (b *Bot) connect(ctx context.Context, opener ConnectionOpener) (*websocket.Conn, error) {
+	return retry.DoWithData(ctx, func(ctx context.Context) (*websocket.Conn, error) {
+		resp, err := opener.OpenConnection(ctx)
+		if err != nil {
+			slog.Warn("failed to open connection", slog.Any("error", err))
+			return nil, err
+		}
+		conn, _, err := websocket.DefaultDialer.DialContext(ctx, resp.URL, nil)
+		if err != nil {
+			slog.Warn("failed to dial websocket", slog.Any("error", err))
+			return nil, err
+		}
+		return conn, nil
+	}, retry.WithMaxRetries(b.options.reconnectRetries))
+}
+
+// drain은 처리 중인 이벤트가 모두 끝날 때까지 기다립니다.
+// shutdownTimeout이 지나면 핸들러의 컨텍스트를 취소하고 끝나기를 기다립니다.
+func (b *Bot) drain(d *dispatcher, cancelHandlers context.CancelFunc) {
+	done := make(chan struct{})
+	go func() {
+		d.stop()
+		close(done)
+	}()
+
+	timer := time.NewTimer(b.options.shutdownTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+	case <-timer.C:
+		slog.Warn("shutdown timeout exceeded, cancelling in-flight handlers")
+		cancelHandlers()
+		<-done
+	}
+}
+
+// serveConn은 연결이 닫히거나 ctx가 취소될 때까지 이벤트를 읽어 처리합니다.
+// 핸들러는 연결과 관계없이 handlerCtx로 실행됩니다.
+//
+// refresh가 nil이 아니면 disconnect 이벤트를 받았을 때 바로 반환하지 않고
+// refresh로 알린 뒤 Slack이 연결을 닫거나 standbyGrace가 지날 때까지 이벤트를 더 받습니다.
+func (b *Bot) serveConn(ctx, handlerCtx context.Context, c *connection, d *dispatcher, refresh chan<- struct{}) {
+	slog.Info("websocket connection established")
+
+	var grace <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-grace:
+			return
+		case e, ok := <-c.events:
 			if !ok {
-				return nil
+				return
 			}
+			// 대기열에서 기다린 시간도 알 수 있도록 핸들러에 이벤트를 받은 시각을 전달한다.
+			eventCtx := WithReceivedAt(handlerCtx, time.Now())
 			switch e.Type {
 			case event.SocketEventTypeHello:
 				slog.Info("received hello event")
 			case event.SocketEventTypeDisconnect:
-				slog.Info("received disconnect event")
-				connCancel()
+				slog.Info("received disconnect event", slog.String("reason", e.OfDisconnect.Reason))
+				if refresh == nil {
+					return
+				}
+				select {
+				case refresh <- struct{}{}:
+				default:
+				}
+				if grace == nil {
+					grace = time.After(b.options.standbyGrace)
+				}
 			case event.SocketEventTypeEventsAPI:
-				resp := map[string]any{"envelope_id": e.OfEventsAPI.EnvelopeID}
-				if err := conn.WriteJSON(resp); err != nil {
-					slog.Warn("failed to respond events api", slog.Any("error", err))
+				c.send(map[string]any{"envelope_id": e.OfEventsAPI.EnvelopeID})
+				if b.isDuplicate(e.OfEventsAPI) {
+					continue
 				}
-				b.handler.HandleEventsAPI(connCtx, e.OfEventsAPI.Payload)
+				b.dispatchEventsAPI(eventCtx, d, e.OfEventsAPI.Payload)
 			case event.SocketEventTypeInteractive:
-				resp := map[string]any{"envelope_id": e.OfInteractive.EnvelopeID}
-				if err := conn.WriteJSON(resp); err != nil {
-					slog.Warn("failed to respond interactive", slog.Any("error", err))
+				if errs := b.validateViewSubmission(eventCtx, e.OfInteractive.Payload); len(errs) > 0 {
+					c.send(map[string]any{
+						"envelope_id": e.OfInteractive.EnvelopeID,
+						"payload": &interactive.ViewSubmissionResponse{
+							ResponseAction: interactive.ResponseActionErrors,
+							Errors:         errs,
+						},
+					})
+					continue
 				}
-				b.handler.HandleInteractive(connCtx, e.OfInteractive.Payload)
+				c.send(map[string]any{"envelope_id": e.OfInteractive.EnvelopeID})
+				b.dispatchInteractive(eventCtx, d, e.OfInteractive.Payload)
+			case event.SocketEventTypeSlashCommands:
+				c.send(map[string]any{"envelope_id": e.OfSlashCommands.EnvelopeID})
+				b.dispatchSlashCommand(eventCtx, d, e.OfSlashCommands.Payload)
 			default:
 				slog.Warn("received unknown event type", slog.String("raw", string(e.Raw)))
 			}
@@ -69,24 +265,69 @@ func (b *Bot) Run(ctx context.Context, url string) error {
 	}
 }
 
-func receiveEvent(conn *websocket.Conn) chan event.SocketEvent {
-	ch := make(chan event.SocketEvent, 1)
-	go func() {
-		_, msg, err := conn.ReadMessage()
-		if err != nil {
-			if !errors.Is(err, net.ErrClosed) {
-				slog.Error("failed to read websocket message", slog.Any("error", err))
-			}
-			close(ch)
-			return
-		}
-		var e event.SocketEvent
-		if err := json.Unmarshal(msg, &e); err != nil {
-			slog.Error("failed to unmarshal websocket message", slog.Any("error", err))
-			close(ch)
-			return
-		}
-		ch <- e
-	}()
-	return ch
+// DuplicateCount는 재전송되어 처리하지 않고 버린 이벤트 수를 반환합니다.
+func (b *Bot) DuplicateCount() int64 {
+	return b.duplicates.Load()
+}
+
+// isDuplicate는 이미 받은 이벤트가 재전송된 것인지 확인합니다.
+func (b *Bot) isDuplicate(e *event.EventsAPI) bool {
+	if e.Payload == nil || e.Payload.OfEventCallback == nil || e.Payload.OfEventCallback.EventID == "" {
+		return false
+	}
+
+	eventID := e.Payload.OfEventCallback.EventID
+	if b.options.dedupStore.Add(eventID) {
+		return false
+	}
+
+	total := b.duplicates.Add(1)
+	slog.Info("dropped duplicate event",
+		slog.String("event_id", eventID),
+		slog.Int("retry_attempt", e.RetryAttempt),
+		slog.String("retry_reason", e.RetryReason),
+		slog.Int64("total", total))
+	return true
+}
+
+func (b *Bot) dispatchEventsAPI(ctx context.Context, d *dispatcher, payload *eventsapi.Payload) {
+	ok := d.dispatch(eventsAPIKey(payload), func() {
+		b.handler.HandleEventsAPI(ctx, payload)
+	})
+	if ok {
+		return
+	}
+
+	slog.Warn("event queue is full, rejecting events api")
+	if busy, ok := b.handler.(BusyHandler); ok {
+		// 읽기 루프가 막히지 않도록 별도의 고루틴에서 응답한다.
+		go busy.HandleBusy(ctx, payload)
+	}
+}
+
+// validateViewSubmission은 핸들러가 ViewSubmissionValidator를 구현하면 모달 제출의 입력 값 오류를 반환합니다.
+func (b *Bot) validateViewSubmission(ctx context.Context, payload *interactive.Payload) map[string]string {
+	validator, ok := b.handler.(ViewSubmissionValidator)
+	if !ok || payload == nil || payload.Type != interactive.PayloadTypeViewSubmission || payload.OfViewSubmission == nil {
+		return nil
+	}
+	return validator.ValidateViewSubmission(ctx, payload.OfViewSubmission)
+}
+
+func (b *Bot) dispatchInteractive(ctx context.Context, d *dispatcher, payload *interactive.Payload) {
+	ok := d.dispatch(interactiveKey(payload), func() {
+		b.handler.HandleInteractive(ctx, payload)
+	})
+	if !ok {
+		slog.Warn("event queue is full, dropping interactive", slog.String("type", string(payload.Type)))
+	}
+}
+
+func (b *Bot) dispatchSlashCommand(ctx context.Context, d *dispatcher, payload *slashcommand.Payload) {
+	ok := d.dispatch(slashCommandKey(payload), func() {
+		b.handler.HandleSlashCommand(ctx, payload)
+	})
+	if !ok {
+		slog.Warn("event queue is full, dropping slash command", slog.String("command", payload.Command))
+	}
 }
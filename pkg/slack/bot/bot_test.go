@@ -0,0 +1,416 @@
+package bot_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/api"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/bot"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/eventsapi"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/slashcommand"
+)
+
+type recorder struct {
+	delay time.Duration
+
+	mu       sync.Mutex
+	texts    []string
+	ctxErrs  []error
+	received []time.Time
+	handled  chan struct{}
+}
+
+func newRecorder(delay time.Duration) *recorder {
+	return &recorder{
+		delay:   delay,
+		handled: make(chan struct{}, 16),
+	}
+}
+
+func (r *recorder) HandleEventsAPI(ctx context.Context, payload *eventsapi.Payload) {
+	time.Sleep(r.delay)
+
+	r.mu.Lock()
+	r.texts = append(r.texts, payload.OfEventCallback.Event.OfMessage.Text)
+	r.ctxErrs = append(r.ctxErrs, ctx.Err())
+	r.received = append(r.received, bot.ReceivedAtFrom(ctx))
+	r.mu.Unlock()
+
+	r.handled <- struct{}{}
+}
+
+func (r *recorder) HandleInteractive(ctx context.Context, payload *interactive.Payload) {}
+
+func (r *recorder) HandleSlashCommand(ctx context.Context, payload *slashcommand.Payload) {}
+
+func (r *recorder) result() ([]string, []error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.texts), slices.Clone(r.ctxErrs)
+}
+
+type opener struct {
+	url   string
+	calls atomic.Int32
+}
+
+func (o *opener) OpenConnection(ctx context.Context) (*api.OpenConnectionResponse, error) {
+	o.calls.Add(1)
+	return &api.OpenConnectionResponse{URL: o.url}, nil
+}
+
+// newServer는 연결마다 serve를 실행하는 WebSocket 서버를 띄우고 URL을 반환합니다.
+// serve에는 몇 번째 연결인지가 0부터 전달됩니다.
+func newServer(t *testing.T, serve func(n int, conn *websocket.Conn)) string {
+	t.Helper()
+
+	var (
+		upgrader websocket.Upgrader
+		count    atomic.Int32
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade: %v", err)
+			return
+		}
+		defer conn.Close()
+		serve(int(count.Add(1)-1), conn)
+	}))
+	t.Cleanup(srv.Close)
+
+	return "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func messageEvent(envelopeID, eventID, text string) string {
+	return fmt.Sprintf(`{ "type": "events_api", "envelope_id": %q, "payload": { "type": "event_callback", "event_id": %q, "event": { "type": "message", "channel": "D024BE91L", "user": "U2147483697", "text": %q, "ts": "1355517523.000005" } } }`, envelopeID, eventID, text)
+}
+
+func writeText(t *testing.T, conn *websocket.Conn, msg string) {
+	t.Helper()
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+		t.Errorf("failed to write message: %v", err)
+	}
+}
+
+func readAck(t *testing.T, conn *websocket.Conn) string {
+	t.Helper()
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	var ack struct {
+		EnvelopeID string `json:"envelope_id"`
+	}
+	if err := conn.ReadJSON(&ack); err != nil {
+		t.Errorf("failed to read ack: %v", err)
+	}
+	return ack.EnvelopeID
+}
+
+// waitClosed는 클라이언트가 연결을 닫을 때까지 읽기를 계속해 ping에 응답합니다.
+func waitClosed(conn *websocket.Conn) {
+	_ = conn.SetReadDeadline(time.Time{})
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+func waitHandled(t *testing.T, r *recorder, n int) {
+	t.Helper()
+	for range n {
+		select {
+		case <-r.handled:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for handler")
+		}
+	}
+}
+
+func TestBotRun(t *testing.T) {
+	var acks []string
+	url := newServer(t, func(n int, conn *websocket.Conn) {
+		writeText(t, conn, `{ "type": "hello", "num_connections": 1 }`)
+		writeText(t, conn, messageEvent("env-1", "Ev01", "first"))
+		writeText(t, conn, messageEvent("env-2", "Ev02", "second"))
+		writeText(t, conn, messageEvent("env-3", "Ev03", "third"))
+		writeText(t, conn, messageEvent("env-4", "Ev02", "second"))
+		for range 4 {
+			acks = append(acks, readAck(t, conn))
+		}
+		msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+		_ = conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+	})
+
+	r := newRecorder(0)
+	b := bot.NewBot(r)
+	if err := b.Run(context.Background(), url); err != nil {
+		t.Fatalf("failed to run bot: %v", err)
+	}
+
+	if want := []string{"env-1", "env-2", "env-3", "env-4"}; !slices.Equal(acks, want) {
+		t.Errorf("acks = %v, want %v", acks, want)
+	}
+	texts, _ := r.result()
+	if want := []string{"first", "second", "third"}; !slices.Equal(texts, want) {
+		t.Errorf("handled = %v, want %v", texts, want)
+	}
+	r.mu.Lock()
+	for i, received := range r.received {
+		if received.IsZero() {
+			t.Errorf("received time of event %d is not set", i)
+		}
+	}
+	r.mu.Unlock()
+	if b.DuplicateCount() != 1 {
+		t.Errorf("duplicate count = %d, want 1", b.DuplicateCount())
+	}
+}
+
+func TestBotRunDrainsHandlersOnShutdown(t *testing.T) {
+	acked := make(chan struct{})
+	url := newServer(t, func(n int, conn *websocket.Conn) {
+		writeText(t, conn, messageEvent("env-1", "Ev01", "slow"))
+		readAck(t, conn)
+		close(acked)
+		waitClosed(conn)
+	})
+
+	r := newRecorder(200 * time.Millisecond)
+	b := bot.NewBot(r)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-acked
+		cancel()
+	}()
+	if err := b.Run(ctx, url); err != nil {
+		t.Fatalf("failed to run bot: %v", err)
+	}
+
+	texts, ctxErrs := r.result()
+	if !slices.Equal(texts, []string{"slow"}) {
+		t.Fatalf("handled = %v, want [slow]", texts)
+	}
+	if ctxErrs[0] != nil {
+		t.Errorf("handler context was cancelled: %v", ctxErrs[0])
+	}
+}
+
+func TestBotRunHeartbeatTimeout(t *testing.T) {
+	release := make(chan struct{})
+	url := newServer(t, func(n int, conn *websocket.Conn) {
+		// 읽지 않으므로 ping에 응답하지 않는다.
+		<-release
+	})
+	t.Cleanup(func() { close(release) })
+
+	b := bot.NewBot(newRecorder(0), bot.WithHeartbeat(20*time.Millisecond, 100*time.Millisecond))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- b.Run(context.Background(), url)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("failed to run bot: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("bot did not detect unresponsive connection")
+	}
+}
+
+func TestBotServe(t *testing.T) {
+	testCases := []struct {
+		desc string
+		opts []bot.Option
+	}{
+		{
+			desc: "reconnect",
+		},
+		{
+			desc: "warm standby",
+			opts: []bot.Option{bot.WithWarmStandby(time.Second)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			o := &opener{}
+			o.url = newServer(t, func(n int, conn *websocket.Conn) {
+				switch n {
+				case 0:
+					writeText(t, conn, messageEvent("env-1", "Ev01", "before"))
+					readAck(t, conn)
+					writeText(t, conn, `{ "type": "disconnect", "reason": "refresh_requested" }`)
+				case 1:
+					// 이전 연결에서 받은 이벤트가 다시 전달되어도 한 번만 처리된다.
+					writeText(t, conn, messageEvent("env-2", "Ev01", "before"))
+					writeText(t, conn, messageEvent("env-3", "Ev02", "after"))
+					readAck(t, conn)
+					readAck(t, conn)
+				}
+				waitClosed(conn)
+			})
+
+			r := newRecorder(0)
+			b := bot.NewBot(r, tc.opts...)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error, 1)
+			go func() {
+				done <- b.Serve(ctx, o)
+			}()
+
+			waitHandled(t, r, 2)
+			cancel()
+			if err := <-done; err != nil {
+				t.Fatalf("failed to serve bot: %v", err)
+			}
+
+			texts, _ := r.result()
+			if want := []string{"before", "after"}; !slices.Equal(texts, want) {
+				t.Errorf("handled = %v, want %v", texts, want)
+			}
+			if o.calls.Load() != 2 {
+				t.Errorf("open connection calls = %d, want 2", o.calls.Load())
+			}
+		})
+	}
+}
+
+// counter는 처리한 이벤트 수만 세는 핸들러입니다.
+type counter struct {
+	handled atomic.Int32
+}
+
+func (c *counter) HandleEventsAPI(ctx context.Context, payload *eventsapi.Payload) {
+	time.Sleep(time.Millisecond)
+	c.handled.Add(1)
+}
+
+func (c *counter) HandleInteractive(ctx context.Context, payload *interactive.Payload) {}
+
+func (c *counter) HandleSlashCommand(ctx context.Context, payload *slashcommand.Payload) {}
+
+func TestBotServeShutdownWhileReceiving(t *testing.T) {
+	for i := range 20 {
+		o := &opener{}
+		o.url = newServer(t, func(n int, conn *websocket.Conn) {
+			go waitClosed(conn)
+			// 클라이언트가 연결을 닫을 때까지 이벤트를 계속 보낸다.
+			for j := 0; ; j++ {
+				msg := messageEvent(fmt.Sprintf("env-%d", j), fmt.Sprintf("Ev%d-%d", i, j), "flood")
+				if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+					return
+				}
+			}
+		})
+
+		c := &counter{}
+		b := bot.NewBot(c, bot.WithWorkers(2), bot.WithQueueSize(2))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() {
+			done <- b.Serve(ctx, o)
+		}()
+
+		for c.handled.Load() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("failed to serve bot: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("bot did not shut down")
+		}
+	}
+}
+
+// validator는 질문이 비어있는 모달 제출을 거부하고 처리한 모달 제출 수를 셉니다.
+type validator struct {
+	counter
+	submitted atomic.Int32
+}
+
+func (v *validator) HandleInteractive(ctx context.Context, payload *interactive.Payload) {
+	v.submitted.Add(1)
+}
+
+func (v *validator) ValidateViewSubmission(ctx context.Context, payload *interactive.ViewSubmissionPayload) map[string]string {
+	if value, _ := payload.View.State.Value("query", "query"); value.Value == "" {
+		return map[string]string{"query": "질문을 입력해주세요."}
+	}
+	return nil
+}
+
+func viewSubmissionEvent(envelopeID, query string) string {
+	return fmt.Sprintf(`{ "type": "interactive", "envelope_id": %q, "payload": { "type": "view_submission", "view": { "id": "V01", "type": "modal", "callback_id": "search", "state": { "values": { "query": { "query": { "type": "plain_text_input", "value": %q } } } } } } }`, envelopeID, query)
+}
+
+func TestBotServeValidatesViewSubmission(t *testing.T) {
+	type ack struct {
+		EnvelopeID string                              `json:"envelope_id"`
+		Payload    *interactive.ViewSubmissionResponse `json:"payload"`
+	}
+
+	acks := make(chan ack, 2)
+	o := &opener{}
+	o.url = newServer(t, func(n int, conn *websocket.Conn) {
+		for _, e := range []string{viewSubmissionEvent("env-1", ""), viewSubmissionEvent("env-2", "결제 승인 실패")} {
+			writeText(t, conn, e)
+			_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+			var a ack
+			if err := conn.ReadJSON(&a); err != nil {
+				t.Errorf("failed to read ack: %v", err)
+			}
+			acks <- a
+		}
+		waitClosed(conn)
+	})
+
+	v := &validator{}
+	b := bot.NewBot(v)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- b.Serve(ctx, o)
+	}()
+
+	rejected, accepted := <-acks, <-acks
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("failed to serve bot: %v", err)
+	}
+
+	if rejected.EnvelopeID != "env-1" || rejected.Payload == nil {
+		t.Fatalf("rejected ack = %+v, want errors payload for env-1", rejected)
+	}
+	if rejected.Payload.ResponseAction != interactive.ResponseActionErrors || rejected.Payload.Errors["query"] == "" {
+		t.Errorf("rejected payload = %+v, want errors on query", rejected.Payload)
+	}
+	if accepted.EnvelopeID != "env-2" || accepted.Payload != nil {
+		t.Errorf("accepted ack = %+v, want plain ack for env-2", accepted)
+	}
+	if n := v.submitted.Load(); n != 1 {
+		t.Errorf("handled submissions = %d, want 1", n)
+	}
+}
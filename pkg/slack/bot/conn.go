@@ -0,0 +1,165 @@
+package bot
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/event"
+)
+
+const writeWait = 10 * time.Second
+
+// connection은 하나의 WebSocket 연결을 감쌉니다.
+//
+// 메시지는 하나의 읽기 고루틴이 읽어 events로 전달하고, 응답과 ping은 하나의
+// 쓰기 고루틴이 보냅니다. 일정 시간 동안 아무 프레임도 받지 못하면 읽기 기한이
+// 지나 연결이 끊어진 것으로 판단하고 events를 닫습니다.
+type connection struct {
+	conn *websocket.Conn
+
+	events chan event.SocketEvent
+	writes chan any
+	done   chan struct{}
+
+	pingInterval time.Duration
+	pongWait     time.Duration
+
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func newConnection(conn *websocket.Conn, pingInterval, pongWait time.Duration) *connection {
+	c := &connection{
+		conn:         conn,
+		events:       make(chan event.SocketEvent),
+		writes:       make(chan any, 16),
+		done:         make(chan struct{}),
+		pingInterval: pingInterval,
+		pongWait:     pongWait,
+	}
+
+	c.wg.Add(2)
+	go c.readLoop()
+	go c.writeLoop()
+
+	return c
+}
+
+// send는 쓰기 고루틴을 통해 메시지를 보냅니다.
+// 연결이 이미 닫혔으면 false를 반환합니다.
+func (c *connection) send(msg any) bool {
+	select {
+	case c.writes <- msg:
+		return true
+	case <-c.done:
+		return false
+	}
+}
+
+// close는 close 프레임을 보낸 뒤 연결을 닫고 두 고루틴이 끝날 때까지 기다립니다.
+func (c *connection) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+		_ = c.conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(writeWait))
+		if err := c.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			slog.Warn("failed to close websocket connection", slog.Any("error", err))
+		}
+	})
+	c.wg.Wait()
+}
+
+func (c *connection) readLoop() {
+	defer c.wg.Done()
+	defer close(c.events)
+
+	extend := func() {
+		_ = c.conn.SetReadDeadline(time.Now().Add(c.pongWait))
+	}
+	extend()
+	c.conn.SetPongHandler(func(string) error {
+		extend()
+		return nil
+	})
+	c.conn.SetPingHandler(func(data string) error {
+		extend()
+		err := c.conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(writeWait))
+		if errors.Is(err, websocket.ErrCloseSent) {
+			return nil
+		}
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return nil
+		}
+		return err
+	})
+
+	for {
+		_, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			if !isClosedError(err) {
+				slog.Error("failed to read websocket message", slog.Any("error", err))
+			}
+			// 읽기가 끝나면 쓰기 고루틴도 멈춘다.
+			c.closeOnce.Do(func() {
+				close(c.done)
+				_ = c.conn.Close()
+			})
+			return
+		}
+		extend()
+
+		var e event.SocketEvent
+		if err := json.Unmarshal(msg, &e); err != nil {
+			slog.Error("failed to unmarshal websocket message", slog.Any("error", err))
+			continue
+		}
+
+		select {
+		case c.events <- e:
+		case <-c.done:
+			return
+		}
+	}
+}
+
+func (c *connection) writeLoop() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case msg := <-c.writes:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteJSON(msg); err != nil {
+				slog.Warn("failed to write websocket message", slog.Any("error", err))
+				_ = c.conn.Close()
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
+				slog.Warn("failed to send ping", slog.Any("error", err))
+				_ = c.conn.Close()
+				return
+			}
+		}
+	}
+}
+
+// isClosedError는 연결이 정상적으로 닫혀 발생한 에러인지 확인합니다.
+func isClosedError(err error) bool {
+	if errors.Is(err, net.ErrClosed) {
+		return true
+	}
+	return websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway)
+}
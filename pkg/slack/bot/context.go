@@ -0,0 +1,23 @@
+package bot
+
+import (
+	"context"
+	"time"
+)
+
+type receivedAtKeyType int
+
+const receivedAtKey receivedAtKeyType = iota
+
+// WithReceivedAt은 이벤트를 받은 시각을 저장합니다.
+// 핸들러에 전달되는 컨텍스트에는 Bot이 이벤트를 읽은 시각이 저장되어 있습니다.
+func WithReceivedAt(parent context.Context, t time.Time) context.Context {
+	return context.WithValue(parent, receivedAtKey, t)
+}
+
+// ReceivedAtFrom은 이벤트를 받은 시각을 반환합니다. 저장된 시각이 없으면 zero value를 반환합니다.
+// 대기열에서 기다린 시간과 관계없이 response_url의 발급 시각 등을 계산할 때 사용합니다.
+func ReceivedAtFrom(ctx context.Context) time.Time {
+	info, _ := ctx.Value(receivedAtKey).(time.Time)
+	return info
+}
@@ -0,0 +1,51 @@
+package bot
+
+import (
+	"sync"
+	"time"
+)
+
+// DedupStore는 이미 받은 이벤트 ID를 기록하여 재전송된 이벤트를 걸러냅니다.
+type DedupStore interface {
+	// Add는 이벤트 ID를 기록하고, 처음 기록된 ID인 경우 true를 반환합니다.
+	Add(id string) bool
+}
+
+var _ DedupStore = (*MemoryDedupStore)(nil)
+
+// MemoryDedupStore는 이벤트 ID를 ttl 동안 메모리에 보관하는 DedupStore입니다.
+type MemoryDedupStore struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	seen      map[string]time.Time
+	lastPrune time.Time
+}
+
+func NewMemoryDedupStore(ttl time.Duration) *MemoryDedupStore {
+	return &MemoryDedupStore{
+		ttl:       ttl,
+		seen:      make(map[string]time.Time),
+		lastPrune: time.Now(),
+	}
+}
+
+func (s *MemoryDedupStore) Add(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastPrune) > s.ttl {
+		for k, t := range s.seen {
+			if now.Sub(t) > s.ttl {
+				delete(s.seen, k)
+			}
+		}
+		s.lastPrune = now
+	}
+
+	if t, ok := s.seen[id]; ok && now.Sub(t) <= s.ttl {
+		return false
+	}
+	s.seen[id] = now
+	return true
+}
@@ -0,0 +1,28 @@
+package bot_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/bot"
+)
+
+func TestMemoryDedupStore(t *testing.T) {
+	store := bot.NewMemoryDedupStore(50 * time.Millisecond)
+
+	if !store.Add("Ev01") {
+		t.Fatalf("expected first event to be added")
+	}
+	if store.Add("Ev01") {
+		t.Fatalf("expected duplicate event to be rejected")
+	}
+	if !store.Add("Ev02") {
+		t.Fatalf("expected different event to be added")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	if !store.Add("Ev01") {
+		t.Fatalf("expected expired event to be added again")
+	}
+}
@@ -0,0 +1,97 @@
+package bot
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// dispatcher는 동시에 실행하는 작업 수를 제한하면서 작업을 처리합니다.
+//
+// 같은 키를 가진 작업은 키별 대기열에 쌓여 도착한 순서대로 하나씩 처리됩니다.
+// 키마다 대기열이 따로 있으므로 한 스레드의 작업이 오래 걸려도 다른 스레드의 작업은
+// 기다리지 않고 빈 실행 슬롯에서 처리됩니다.
+// 아직 시작하지 못한 작업이 capacity개를 넘으면 작업을 받지 않고 즉시 실패를 반환합니다.
+type dispatcher struct {
+	// 실행 슬롯. 작업을 실행하는 동안 하나를 차지합니다.
+	slots    chan struct{}
+	capacity int
+	next     atomic.Uint64
+	wg       sync.WaitGroup
+
+	mu      sync.Mutex
+	closed  bool
+	pending int
+	// 키별 대기열. 키가 있으면 그 키의 작업을 실행하는 고루틴이 있습니다.
+	queues map[string][]func()
+}
+
+// newDispatcher는 최대 workers개의 작업을 동시에 실행하고
+// 워커마다 queueSize개, 전체 workers×queueSize개까지 작업을 대기시키는 dispatcher를 생성합니다.
+func newDispatcher(workers, queueSize int) *dispatcher {
+	return &dispatcher{
+		slots:    make(chan struct{}, workers),
+		capacity: workers * queueSize,
+		queues:   make(map[string][]func()),
+	}
+}
+
+// dispatch는 key의 대기열에 작업을 추가합니다.
+// key가 비어있으면 다른 작업과 순서를 맞추지 않는 작업으로 보고 따로 처리합니다.
+// 대기열이 가득 찼거나 stop이 호출된 뒤에는 false를 반환합니다.
+func (d *dispatcher) dispatch(key string, task func()) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed || d.pending >= d.capacity {
+		return false
+	}
+	if key == "" {
+		// 빈 키는 ':'로 시작하지 않으므로 다른 키와 겹치지 않는다.
+		key = ":" + strconv.FormatUint(d.next.Add(1), 10)
+	}
+
+	d.pending++
+	queue, running := d.queues[key]
+	d.queues[key] = append(queue, task)
+	if !running {
+		d.wg.Add(1)
+		go d.run(key)
+	}
+	return true
+}
+
+// run은 key의 대기열이 빌 때까지 작업을 하나씩 꺼내 실행 슬롯을 얻은 뒤 실행합니다.
+func (d *dispatcher) run(key string) {
+	defer d.wg.Done()
+
+	for {
+		d.mu.Lock()
+		queue := d.queues[key]
+		if len(queue) == 0 {
+			delete(d.queues, key)
+			d.mu.Unlock()
+			return
+		}
+		task := queue[0]
+		d.queues[key] = queue[1:]
+		d.mu.Unlock()
+
+		d.slots <- struct{}{}
+		d.mu.Lock()
+		d.pending--
+		d.mu.Unlock()
+
+		task()
+		<-d.slots
+	}
+}
+
+// stop은 더 이상 작업을 받지 않고 대기 중인 작업이 모두 처리될 때까지 기다립니다.
+func (d *dispatcher) stop() {
+	d.mu.Lock()
+	d.closed = true
+	d.mu.Unlock()
+
+	d.wg.Wait()
+}
@@ -0,0 +1,101 @@
+package bot
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func waitSignal(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+	select {
+	case <-ch:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("timed out waiting for task")
+	}
+}
+
+func TestDispatcherOrdering(t *testing.T) {
+	d := newDispatcher(4, 100)
+
+	var (
+		mu    sync.Mutex
+		order = map[string][]int{}
+	)
+	for i := range 100 {
+		for _, key := range []string{"C1:1.0", "C1:2.0", "C2:1.0"} {
+			ok := d.dispatch(key, func() {
+				mu.Lock()
+				order[key] = append(order[key], i)
+				mu.Unlock()
+			})
+			if !ok {
+				t.Fatalf("dispatch(%q) rejected task %d", key, i)
+			}
+		}
+	}
+	d.stop()
+
+	for key, got := range order {
+		if len(got) != 100 || !slices.IsSorted(got) {
+			t.Errorf("tasks of %q ran out of order: %v", key, got)
+		}
+	}
+}
+
+func TestDispatcherNoHeadOfLineBlocking(t *testing.T) {
+	d := newDispatcher(2, 4)
+	defer d.stop()
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	d.dispatch("slow", func() {
+		close(started)
+		<-release
+	})
+	waitSignal(t, started)
+
+	// 느린 작업이 끝나지 않아도 다른 키의 작업은 처리된다.
+	for _, key := range []string{"a", "b", "c", ""} {
+		done := make(chan struct{})
+		if !d.dispatch(key, func() { close(done) }) {
+			t.Fatalf("dispatch(%q) rejected task", key)
+		}
+		waitSignal(t, done)
+	}
+	close(release)
+}
+
+func TestDispatcherBackpressure(t *testing.T) {
+	d := newDispatcher(1, 2)
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	d.dispatch("a", func() {
+		close(started)
+		<-release
+	})
+	waitSignal(t, started)
+
+	// 실행 중인 작업은 대기열을 차지하지 않는다.
+	var ran []string
+	for _, key := range []string{"a", "b"} {
+		if !d.dispatch(key, func() { ran = append(ran, key) }) {
+			t.Fatalf("dispatch(%q) rejected task", key)
+		}
+	}
+	if d.dispatch("c", func() {}) {
+		t.Errorf("dispatch accepted task over capacity")
+	}
+
+	close(release)
+	d.stop()
+
+	if len(ran) != 2 {
+		t.Errorf("ran = %v, want both queued tasks", ran)
+	}
+	if d.dispatch("a", func() {}) {
+		t.Errorf("dispatch accepted task after stop")
+	}
+}
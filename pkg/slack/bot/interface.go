@@ -5,9 +5,29 @@ import (
 
 	"github.com/joyfuldevs/project-lumos/pkg/slack/eventsapi"
 	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/slashcommand"
 )
 
 type EventHandler interface {
 	HandleEventsAPI(ctx context.Context, payload *eventsapi.Payload)
 	HandleInteractive(ctx context.Context, payload *interactive.Payload)
+	HandleSlashCommand(ctx context.Context, payload *slashcommand.Payload)
+}
+
+// BusyHandler는 처리 대기열이 가득 차 이벤트를 처리할 수 없을 때 호출됩니다.
+//
+// EventHandler가 BusyHandler를 함께 구현하면 사용자에게 잠시 후 다시 시도해달라는
+// 응답을 보내는 등의 처리를 할 수 있습니다.
+type BusyHandler interface {
+	HandleBusy(ctx context.Context, payload *eventsapi.Payload)
+}
+
+// ViewSubmissionValidator는 모달 제출(view_submission)을 확인 응답하기 전에 입력 값을 검사합니다.
+//
+// EventHandler가 ViewSubmissionValidator를 함께 구현하면 반환한 입력 블록 ID별 오류 메시지를
+// 확인 응답에 담아 모달을 닫지 않고 해당 입력 블록에 오류를 표시합니다.
+// 오류가 있으면 HandleInteractive를 호출하지 않습니다.
+// 확인 응답은 3초 안에 보내야 하므로 외부 호출 없이 빠르게 반환해야 합니다.
+type ViewSubmissionValidator interface {
+	ValidateViewSubmission(ctx context.Context, payload *interactive.ViewSubmissionPayload) map[string]string
 }
@@ -0,0 +1,66 @@
+package bot
+
+import (
+	"github.com/joyfuldevs/project-lumos/pkg/slack/eventsapi"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/slashcommand"
+)
+
+// eventsAPIKey는 같은 스레드의 이벤트를 순서대로 처리하기 위한 키를 반환합니다.
+func eventsAPIKey(payload *eventsapi.Payload) string {
+	if payload == nil || payload.OfEventCallback == nil {
+		return ""
+	}
+
+	e := payload.OfEventCallback.Event
+	switch e.Type {
+	case eventsapi.EventTypeMessage:
+		m := e.OfMessage
+		if m.ThreadTimestamp != "" {
+			return m.Channel + ":" + string(m.ThreadTimestamp)
+		}
+		return m.Channel + ":" + string(m.MessageTimestamp)
+	case eventsapi.EventTypeAppMention:
+		m := e.OfAppMention
+		if m.ThreadTimestamp != "" {
+			return m.Channel + ":" + string(m.ThreadTimestamp)
+		}
+		return m.Channel + ":" + string(m.MessageTimestamp)
+	case eventsapi.EventTypeAssistantThreadStarted:
+		at := e.OfAssistantThreadStarted.AssistantThread
+		return at.ChannelID + ":" + string(at.ThreadTimestamp)
+	case eventsapi.EventTypeAssistantThreadContextChanged:
+		at := e.OfAssistantThreadContextChanged.AssistantThread
+		return at.ChannelID + ":" + string(at.ThreadTimestamp)
+	}
+
+	return ""
+}
+
+// interactiveKey는 같은 사용자의 상호작용을 순서대로 처리하기 위한 키를 반환합니다.
+func interactiveKey(payload *interactive.Payload) string {
+	if payload == nil {
+		return ""
+	}
+
+	switch payload.Type {
+	case interactive.PayloadTypeBlockActions:
+		if p := payload.OfBlockActions; p.User != nil {
+			return p.User.ID
+		}
+	case interactive.PayloadTypeMessageActions:
+		if p := payload.OfMessageActions; p.User != nil {
+			return p.User.ID
+		}
+	}
+
+	return ""
+}
+
+// slashCommandKey는 같은 사용자의 슬래시 커맨드를 순서대로 처리하기 위한 키를 반환합니다.
+func slashCommandKey(payload *slashcommand.Payload) string {
+	if payload == nil {
+		return ""
+	}
+	return payload.UserID
+}
@@ -0,0 +1,107 @@
+package bot
+
+import "time"
+
+type botOptions struct {
+	workers    int
+	queueSize  int
+	dedupStore DedupStore
+
+	reconnectRetries int
+	warmStandby      bool
+	standbyGrace     time.Duration
+
+	pingInterval    time.Duration
+	pongWait        time.Duration
+	shutdownTimeout time.Duration
+}
+
+var defaultBotOptions = botOptions{
+	workers:   8,
+	queueSize: 16,
+
+	reconnectRetries: 10,
+	standbyGrace:     30 * time.Second,
+
+	pingInterval:    15 * time.Second,
+	pongWait:        45 * time.Second,
+	shutdownTimeout: 30 * time.Second,
+}
+
+type Option func(*botOptions)
+
+// WithWorkers는 이벤트를 동시에 처리하는 워커 수를 지정합니다.
+// 같은 스레드의 이벤트는 순서대로 하나씩 처리되며, 다른 스레드의 이벤트는 빈 워커에서 처리됩니다.
+// 0 이하의 값은 무시됩니다.
+func WithWorkers(workers int) Option {
+	return func(opt *botOptions) {
+		if workers > 0 {
+			opt.workers = workers
+		}
+	}
+}
+
+// WithQueueSize는 워커별로 대기할 수 있는 이벤트 수를 지정합니다.
+// 전체 대기열에는 워커 수 × queueSize개의 이벤트가 대기할 수 있습니다.
+// 0 이하의 값은 무시됩니다.
+func WithQueueSize(queueSize int) Option {
+	return func(opt *botOptions) {
+		if queueSize > 0 {
+			opt.queueSize = queueSize
+		}
+	}
+}
+
+// WithDedupStore는 재전송된 이벤트를 걸러낼 때 사용할 저장소를 지정합니다.
+// 지정하지 않으면 10분 동안 이벤트 ID를 보관하는 MemoryDedupStore를 사용합니다.
+func WithDedupStore(store DedupStore) Option {
+	return func(opt *botOptions) {
+		opt.dedupStore = store
+	}
+}
+
+// WithReconnectRetries는 Serve가 다시 연결할 때 재시도할 최대 횟수를 지정합니다.
+// 0 미만의 값은 무시됩니다.
+func WithReconnectRetries(retries int) Option {
+	return func(opt *botOptions) {
+		if retries >= 0 {
+			opt.reconnectRetries = retries
+		}
+	}
+}
+
+// WithWarmStandby는 disconnect 이벤트를 받았을 때 기존 연결이 닫히기 전에
+// 새 연결을 미리 열어 두도록 합니다. 기존 연결은 Slack이 닫거나
+// grace 시간이 지날 때까지 이벤트를 계속 받습니다. 0 이하의 grace는 무시됩니다.
+func WithWarmStandby(grace time.Duration) Option {
+	return func(opt *botOptions) {
+		opt.warmStandby = true
+		if grace > 0 {
+			opt.standbyGrace = grace
+		}
+	}
+}
+
+// WithHeartbeat는 ping을 보내는 주기와 연결이 끊어진 것으로 판단할 때까지
+// 기다리는 시간을 지정합니다. timeout 동안 아무 프레임도 받지 못하면 연결을 닫습니다.
+// 0 이하의 값은 무시됩니다.
+func WithHeartbeat(interval, timeout time.Duration) Option {
+	return func(opt *botOptions) {
+		if interval > 0 {
+			opt.pingInterval = interval
+		}
+		if timeout > 0 {
+			opt.pongWait = timeout
+		}
+	}
+}
+
+// WithShutdownTimeout은 종료할 때 처리 중인 이벤트를 기다리는 최대 시간을 지정합니다.
+// 시간이 지나면 핸들러의 컨텍스트를 취소합니다. 0 이하의 값은 무시됩니다.
+func WithShutdownTimeout(timeout time.Duration) Option {
+	return func(opt *botOptions) {
+		if timeout > 0 {
+			opt.shutdownTimeout = timeout
+		}
+	}
+}
@@ -5,24 +5,27 @@ import (
 
 	"github.com/joyfuldevs/project-lumos/pkg/slack/eventsapi"
 	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/slashcommand"
 )
 
 type SocketEventType string
 
 const (
-	SocketEventTypeHello       SocketEventType = "hello"
-	SocketEventTypeDisconnect  SocketEventType = "disconnect"
-	SocketEventTypeEventsAPI   SocketEventType = "events_api"
-	SocketEventTypeInteractive SocketEventType = "interactive"
+	SocketEventTypeHello         SocketEventType = "hello"
+	SocketEventTypeDisconnect    SocketEventType = "disconnect"
+	SocketEventTypeEventsAPI     SocketEventType = "events_api"
+	SocketEventTypeInteractive   SocketEventType = "interactive"
+	SocketEventTypeSlashCommands SocketEventType = "slash_commands"
 )
 
 type SocketEvent struct {
 	Type SocketEventType `json:"type"`
 
-	OfHello       *Hello       `json:"-"`
-	OfDisconnect  *Disconnect  `json:"-"`
-	OfEventsAPI   *EventsAPI   `json:"-"`
-	OfInteractive *Interactive `json:"-"`
+	OfHello         *Hello         `json:"-"`
+	OfDisconnect    *Disconnect    `json:"-"`
+	OfEventsAPI     *EventsAPI     `json:"-"`
+	OfInteractive   *Interactive   `json:"-"`
+	OfSlashCommands *SlashCommands `json:"-"`
 
 	Raw []byte `json:"-"`
 }
@@ -57,6 +60,11 @@ func (s *SocketEvent) UnmarshalJSON(data []byte) error {
 		if err := json.Unmarshal(data, s.OfInteractive); err != nil {
 			return err
 		}
+	case SocketEventTypeSlashCommands:
+		s.OfSlashCommands = &SlashCommands{}
+		if err := json.Unmarshal(data, s.OfSlashCommands); err != nil {
+			return err
+		}
 	}
 	s.Raw = data
 
@@ -87,3 +95,9 @@ type Interactive struct {
 	Payload                *interactive.Payload `json:"payload,omitempty"`
 	AcceptsResponsePayload bool                 `json:"accepts_response_payload"`
 }
+
+type SlashCommands struct {
+	EnvelopeID             string                `json:"envelope_id"`
+	Payload                *slashcommand.Payload `json:"payload,omitempty"`
+	AcceptsResponsePayload bool                  `json:"accepts_response_payload"`
+}
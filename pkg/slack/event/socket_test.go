@@ -33,6 +33,10 @@ func TestUnmarshalSlackEvent(t *testing.T) {
 			desc:       "interactive",
 			jsonString: `{ "type": "interactive", "payload": { "type": "block_actions", "team": { "id": "T123ABC456", "domain": "Duino" }, "user": { "id": "U123ABC456", "username": "RMR", "team_id": "T123ABC456" }, "api_app_id": "AABA1ABCD", "token": "9s8d9as89d8as9d8as989", "container": { "type": "message_attachment", "message_ts": "1548261231.000200", "attachment_id": 1, "channel_id": "C123ABC456", "is_ephemeral": false, "is_app_unfurl": false }, "trigger_id": "12321423423.333649436676.d8c1bb837935619ccad0f624c448ffb3", "channel": { "id": "C123ABC456", "name": "review-updates" }, "message": { "bot_id": "B123ABC456", "type": "message", "text": "Who if I cried out would hear me.", "user": "U123ABC456", "ts": "1548261231.000200" }, "response_url": "https://hooks.slack.com/actions/AABA1ABCD/1232321423432/D09sSasdasdAS9091209", "actions": [{ "action_id": "WaXA", "block_id": "=qXel", "text": { "type": "plain_text", "text": "View", "emoji": true }, "value": "click_me_123", "type": "button", "action_ts": "1548426417.840180" }] }, "envelope_id": "dbdd0ef3-1543-4f94-bfb4-133d0e6c1545", "accepts_response_payload": true }`,
 		},
+		{
+			desc:       "slash_commands",
+			jsonString: `{ "envelope_id": "d7b7c4c2-4d3a-4b1a-9a52-fb3b8d4e2a11", "payload": { "token": "bHKJ2n9AW6Ju3MjciOHfbA1b", "team_id": "T04F7MWMD", "team_domain": "joyfuldevs", "channel_id": "C123ABC456", "channel_name": "dev", "user_id": "U04CJM7DTFX", "user_name": "lumos", "command": "/lumos", "text": "결제 실패 원인", "api_app_id": "A09AP3HFHCH", "is_enterprise_install": "false", "response_url": "https://hooks.slack.com/commands/T04F7MWMD/1234567890/abcdef", "trigger_id": "1234567890.333649436676.d8c1bb837935619ccad0f624c448ffb3" }, "type": "slash_commands", "accepts_response_payload": true }`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -83,6 +87,13 @@ func TestUnmarshalSlackEvent(t *testing.T) {
 				if se.OfInteractive == nil {
 					t.Fatalf("missing interactive event")
 				}
+			case event.SocketEventTypeSlashCommands:
+				if se.OfSlashCommands == nil || se.OfSlashCommands.Payload == nil {
+					t.Fatalf("missing slash commands event")
+				}
+				if se.OfSlashCommands.Payload.Command != "/lumos" || se.OfSlashCommands.Payload.ResponseURL == "" {
+					t.Fatalf("unexpected slash command payload: %+v", se.OfSlashCommands.Payload)
+				}
 			default:
 				t.Fatalf("unexpected event type: %v", se.Type)
 			}
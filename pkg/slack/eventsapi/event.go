@@ -2,6 +2,8 @@ package eventsapi
 
 import (
 	"encoding/json"
+	"regexp"
+	"strings"
 
 	"github.com/joyfuldevs/project-lumos/pkg/slack"
 )
@@ -12,6 +14,7 @@ const (
 	EventTypeMessage                       EventType = "message"
 	EventTypeAssistantThreadStarted        EventType = "assistant_thread_started"
 	EventTypeAssistantThreadContextChanged EventType = "assistant_thread_context_changed"
+	EventTypeAppMention                    EventType = "app_mention"
 )
 
 type Event struct {
@@ -20,6 +23,7 @@ type Event struct {
 	OfMessage                       *Message                            `json:"-"`
 	OfAssistantThreadStarted        *AssistantThreadStartedEvent        `json:"-"`
 	OfAssistantThreadContextChanged *AssistantThreadContextChangedEvent `json:"-"`
+	OfAppMention                    *AppMentionEvent                    `json:"-"`
 }
 
 func (e *Event) UnmarshalJSON(data []byte) error {
@@ -47,6 +51,11 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 		if err := json.Unmarshal(data, e.OfAssistantThreadContextChanged); err != nil {
 			return err
 		}
+	case EventTypeAppMention:
+		e.OfAppMention = &AppMentionEvent{}
+		if err := json.Unmarshal(data, e.OfAppMention); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -86,3 +95,21 @@ type AssistantThreadContextChangedEvent struct {
 	EventTimestamp  slack.Timestamp `json:"event_ts"`
 	AssistantThread AssistantThread `json:"assistant_thread"`
 }
+
+// AppMentionEvent는 채널에서 앱을 멘션한 메시지입니다.
+type AppMentionEvent struct {
+	Channel          string          `json:"channel"`
+	User             string          `json:"user"`
+	Text             string          `json:"text"`
+	MessageTimestamp slack.Timestamp `json:"ts"`
+	EventTimestamp   slack.Timestamp `json:"event_ts"`
+	ThreadTimestamp  slack.Timestamp `json:"thread_ts"`
+	BotID            string          `json:"bot_id"`
+}
+
+var mentionPattern = regexp.MustCompile(`<@[UW][A-Z0-9]+(\|[^>]*)?>`)
+
+// StripMentions는 메시지에서 사용자 멘션(<@U123>)을 제거하고 앞뒤 공백을 정리합니다.
+func StripMentions(text string) string {
+	return strings.TrimSpace(mentionPattern.ReplaceAllString(text, ""))
+}
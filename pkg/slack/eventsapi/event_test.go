@@ -0,0 +1,60 @@
+package eventsapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/eventsapi"
+)
+
+func TestUnmarshalAppMention(t *testing.T) {
+	data := `{ "type": "app_mention", "user": "U061F7AUR", "text": "<@U0LAN0Z89> is it everything a river should be?", "ts": "1515449522.000016", "channel": "C123ABC456", "event_ts": "1515449522000016" }`
+
+	var e eventsapi.Event
+	if err := json.Unmarshal([]byte(data), &e); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	if e.OfAppMention == nil {
+		t.Fatalf("missing app mention event")
+	}
+	if e.OfAppMention.Channel != "C123ABC456" || e.OfAppMention.MessageTimestamp != "1515449522.000016" {
+		t.Errorf("unexpected app mention event: %+v", e.OfAppMention)
+	}
+}
+
+func TestStripMentions(t *testing.T) {
+	testCases := []struct {
+		desc string
+		text string
+		want string
+	}{
+		{
+			desc: "leading mention",
+			text: "<@U0LAN0Z89> PAY-123 상태 어때?",
+			want: "PAY-123 상태 어때?",
+		},
+		{
+			desc: "mention with label",
+			text: "<@W0LAN0Z89|lumos>   결제 실패 원인",
+			want: "결제 실패 원인",
+		},
+		{
+			desc: "multiple lines",
+			text: "<@U0LAN0Z89> 첫 줄\n둘째 줄",
+			want: "첫 줄\n둘째 줄",
+		},
+		{
+			desc: "no mention",
+			text: "안녕하세요",
+			want: "안녕하세요",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := eventsapi.StripMentions(tc.text); got != tc.want {
+				t.Errorf("StripMentions(%q) = %q, want %q", tc.text, got, tc.want)
+			}
+		})
+	}
+}
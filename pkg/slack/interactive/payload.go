@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/joyfuldevs/project-lumos/pkg/slack"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
 )
 
 type PayloadType string
@@ -12,7 +13,7 @@ const (
 	// Received when a user clicks a Block Kit interactive component.
 	PayloadTypeBlockActions PayloadType = "block_actions"
 	// Received when an app action in the message menu is used.
-	PayloadTypeMessageActions PayloadType = "message_actions"
+	PayloadTypeMessageActions PayloadType = "message_action"
 	// Received when a modal is canceled.
 	PayloadTypeViewClosed PayloadType = "view_closed"
 	// Received when a modal is submitted.
@@ -73,6 +74,10 @@ type BlockActionsPayload struct {
 	Team *slack.Team `json:"team"`
 	// The channel where this block action took place.
 	Channel *slack.Channel `json:"channel,omitempty"`
+	// The container where this block action took place.
+	Container *Container `json:"container,omitempty"`
+	// The message where this block action took place, if the block was contained in a message.
+	Message *Message `json:"message,omitempty"`
 	// A short-lived ID that can be used to open modals.
 	TriggerID string `json:"trigger_id,omitempty"`
 	// A short-lived webhook that can be used to send messages in response to interactions.
@@ -83,8 +88,52 @@ type BlockActionsPayload struct {
 	Actions []Action `json:"actions,omitempty"`
 }
 
+// The source of the interaction.
+type Container struct {
+	// The type of the container. e.g., "message", "view", "message_attachment"
+	Type string `json:"type"`
+	// The timestamp of the message that contains the interactive component.
+	MessageTimestamp slack.Timestamp `json:"message_ts,omitempty"`
+	// The channel ID of the message that contains the interactive component.
+	ChannelID string `json:"channel_id,omitempty"`
+	// Whether the message is ephemeral.
+	IsEphemeral bool `json:"is_ephemeral,omitempty"`
+	// The ID of the view that contains the interactive component.
+	ViewID string `json:"view_id,omitempty"`
+}
+
+// The message that contains the interactive component.
+type Message struct {
+	Type            string          `json:"type"`
+	User            string          `json:"user,omitempty"`
+	BotID           string          `json:"bot_id,omitempty"`
+	Text            string          `json:"text"`
+	Timestamp       slack.Timestamp `json:"ts"`
+	ThreadTimestamp slack.Timestamp `json:"thread_ts,omitempty"`
+	// The blocks of the message.
+	Blocks []*blockkit.Block `json:"blocks,omitempty"`
+}
+
 // Received when an app action in the message menu is used.
 type MessageActionsPayload struct {
+	// The callback ID of the shortcut that was used, as configured in the app settings.
+	CallbackID string `json:"callback_id"`
+	// The user who used the shortcut.
+	User *slack.User `json:"user"`
+	// The workspace the app is installed on. Null if the app is org-installed.
+	Team *slack.Team `json:"team"`
+	// The channel where the shortcut was used.
+	Channel *slack.Channel `json:"channel"`
+	// The message that the shortcut was used on.
+	Message *Message `json:"message"`
+	// The timestamp of the message that the shortcut was used on.
+	MessageTimestamp slack.Timestamp `json:"message_ts"`
+	// The timestamp of when the shortcut was used.
+	ActionTimestamp slack.Timestamp `json:"action_ts"`
+	// A short-lived ID that can be used to open modals.
+	TriggerID string `json:"trigger_id"`
+	// A short-lived webhook that can be used to send messages in response to the shortcut.
+	ResponseURL string `json:"response_url"`
 }
 
 // Received when a modal is canceled.
@@ -93,4 +142,83 @@ type ViewClosedPayload struct {
 
 // Received when a modal is submitted.
 type ViewSubmissionPayload struct {
+	// The user who submitted the modal.
+	User *slack.User `json:"user"`
+	// The workspace the app is installed on. Null if the app is org-installed.
+	Team *slack.Team `json:"team"`
+	// The source view of the modal that the user submitted.
+	View *View `json:"view"`
+	// A short-lived ID that can be used to open modals.
+	TriggerID string `json:"trigger_id,omitempty"`
+	// Webhooks that can be used to send messages to the conversations selected in the modal.
+	// Only included when the modal contains a conversations_select or channels_select
+	// with response_url_enabled set to true.
+	ResponseURLs []ViewResponseURL `json:"response_urls,omitempty"`
+}
+
+// A view that the user interacted with, as sent to the app.
+type View struct {
+	blockkit.View
+
+	// The ID of the view. Used to update the view with views.update.
+	ID string `json:"id"`
+	// The workspace the view belongs to.
+	TeamID string `json:"team_id"`
+	// The app that created the view.
+	AppID string `json:"app_id"`
+	// A unique value that changes every time the view is updated.
+	// Used to prevent race conditions when updating the view.
+	Hash string `json:"hash"`
+	// The ID of the first view in the modal stack.
+	RootViewID string `json:"root_view_id,omitempty"`
+	// The ID of the view that was open before this view was pushed.
+	PreviousViewID string `json:"previous_view_id,omitempty"`
+	// The values entered by the user in the view's input blocks.
+	State *ViewState `json:"state,omitempty"`
+}
+
+// The values entered by the user in a view.
+type ViewState struct {
+	// A map of block_id to a map of action_id to the value of the element.
+	Values map[string]map[string]ViewStateValue `json:"values"`
+}
+
+// Value returns the value of the element identified by blockID and actionID.
+func (s *ViewState) Value(blockID, actionID string) (ViewStateValue, bool) {
+	if s == nil {
+		return ViewStateValue{}, false
+	}
+	v, ok := s.Values[blockID][actionID]
+	return v, ok
+}
+
+// The value of an element in a view.
+// Only the field that matches the element type is set.
+type ViewStateValue struct {
+	// The type of the element. e.g., "plain_text_input", "static_select", "datepicker"
+	Type blockkit.BlockElementType `json:"type"`
+	// The value of a plain_text_input element.
+	Value string `json:"value,omitempty"`
+	// The selected option of a static_select, external_select or radio_buttons element.
+	SelectedOption *blockkit.OptionObject `json:"selected_option,omitempty"`
+	// The selected options of a multi_static_select, multi_external_select or checkboxes element.
+	SelectedOptions []*blockkit.OptionObject `json:"selected_options,omitempty"`
+	// The selected date of a datepicker element in the format YYYY-MM-DD.
+	SelectedDate string `json:"selected_date,omitempty"`
+	// The selected user of a users_select element.
+	SelectedUser string `json:"selected_user,omitempty"`
+	// The selected users of a multi_users_select element.
+	SelectedUsers []string `json:"selected_users,omitempty"`
+	// The selected conversation of a conversations_select element.
+	SelectedConversation string `json:"selected_conversation,omitempty"`
+	// The selected channel of a channels_select element.
+	SelectedChannel string `json:"selected_channel,omitempty"`
+}
+
+// A webhook for a conversation selected in a modal.
+type ViewResponseURL struct {
+	BlockID     string `json:"block_id"`
+	ActionID    string `json:"action_id"`
+	ChannelID   string `json:"channel_id"`
+	ResponseURL string `json:"response_url"`
 }
@@ -0,0 +1,80 @@
+package interactive_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/interactive"
+)
+
+func TestUnmarshalPayload(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		jsonString string
+		check      func(t *testing.T, p *interactive.Payload)
+	}{
+		{
+			desc:       "message_action",
+			jsonString: `{ "type": "message_action", "token": "Nj2rfC2hU8mAfgaJLemZgO7H", "action_ts": "1581106241.371594", "team": { "id": "TXXXXXXXX", "domain": "shortcuts-test" }, "user": { "id": "UXXXXXXXXX", "name": "aman", "team_id": "TXXXXXXXX" }, "channel": { "id": "CXXXXXXXXX", "name": "general" }, "callback_id": "ask_lumos", "trigger_id": "944799105734.773906753841.38b5894552bdd4a780554ee59d1f3638", "message_ts": "1581106175.000400", "message": { "type": "message", "user": "UXXXXXXXXX", "text": "java.lang.NullPointerException at PaymentService.approve", "ts": "1581106175.000400", "thread_ts": "1581106100.000200" }, "response_url": "https://hooks.slack.com/app/T00000000/1234567890/abcdef" }`,
+			check: func(t *testing.T, p *interactive.Payload) {
+				ma := p.OfMessageActions
+				if ma == nil {
+					t.Fatalf("missing message actions payload")
+				}
+				if ma.CallbackID != "ask_lumos" || ma.TriggerID == "" || ma.ResponseURL == "" {
+					t.Errorf("unexpected shortcut fields: %+v", ma)
+				}
+				if ma.Channel == nil || ma.Channel.ID != "CXXXXXXXXX" {
+					t.Errorf("unexpected channel: %+v", ma.Channel)
+				}
+				if ma.User == nil || ma.User.ID != "UXXXXXXXXX" {
+					t.Errorf("unexpected user: %+v", ma.User)
+				}
+				if ma.Message == nil || ma.Message.Timestamp != "1581106175.000400" || ma.Message.ThreadTimestamp != "1581106100.000200" {
+					t.Errorf("unexpected message: %+v", ma.Message)
+				}
+			},
+		},
+		{
+			desc:       "view_submission",
+			jsonString: `{ "type": "view_submission", "team": { "id": "T04F7MWMD", "domain": "joyfuldevs" }, "user": { "id": "U04CJM7DTFX", "username": "lumos", "team_id": "T04F7MWMD" }, "api_app_id": "A09AP3HFHCH", "trigger_id": "12466734323.1395872398.3b5f27f8b8b3e5ff3b9f3c0c7e1e5a4b", "view": { "id": "V0PKB1ZFV", "team_id": "T04F7MWMD", "type": "modal", "title": { "type": "plain_text", "text": "상세 검색" }, "submit": { "type": "plain_text", "text": "검색" }, "blocks": [ { "type": "input", "block_id": "search_query", "label": { "type": "plain_text", "text": "질문" }, "element": { "type": "plain_text_input", "action_id": "search_query", "multiline": true } }, { "type": "input", "block_id": "search_status", "label": { "type": "plain_text", "text": "상태" }, "optional": true, "element": { "type": "static_select", "action_id": "search_status", "options": [ { "text": { "type": "plain_text", "text": "완료" }, "value": "Done" } ] } } ], "private_metadata": "{\"channel_id\":\"C123ABC456\"}", "callback_id": "advanced_search", "state": { "values": { "search_query": { "search_query": { "type": "plain_text_input", "value": "결제 승인 실패" } }, "search_status": { "search_status": { "type": "static_select", "selected_option": { "text": { "type": "plain_text", "text": "완료" }, "value": "Done" } } }, "search_since": { "search_since": { "type": "datepicker", "selected_date": "2025-01-01" } } } }, "hash": "156663117.cd33ad1f", "app_id": "A09AP3HFHCH" }, "response_urls": [] }`,
+			check: func(t *testing.T, p *interactive.Payload) {
+				vs := p.OfViewSubmission
+				if vs == nil || vs.View == nil {
+					t.Fatalf("missing view submission payload")
+				}
+				if vs.View.ID != "V0PKB1ZFV" || vs.View.CallbackID != "advanced_search" || vs.View.Hash == "" {
+					t.Errorf("unexpected view: %+v", vs.View)
+				}
+				if len(vs.View.Blocks) != 2 || vs.View.Blocks[0].OfInputBlock == nil {
+					t.Fatalf("unexpected view blocks: %+v", vs.View.Blocks)
+				}
+				if vs.View.Blocks[1].OfInputBlock.Element.OfStaticSelectElement == nil {
+					t.Errorf("missing static select element")
+				}
+				if v, ok := vs.View.State.Value("search_query", "search_query"); !ok || v.Value != "결제 승인 실패" {
+					t.Errorf("unexpected query value: %+v", v)
+				}
+				if v, _ := vs.View.State.Value("search_status", "search_status"); v.SelectedOption == nil || v.SelectedOption.Value != "Done" {
+					t.Errorf("unexpected status value: %+v", v)
+				}
+				if v, _ := vs.View.State.Value("search_since", "search_since"); v.SelectedDate != "2025-01-01" {
+					t.Errorf("unexpected date value: %+v", v)
+				}
+				if _, ok := vs.View.State.Value("search_until", "search_until"); ok {
+					t.Errorf("unexpected value for missing block")
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var p interactive.Payload
+			if err := json.Unmarshal([]byte(tc.jsonString), &p); err != nil {
+				t.Fatalf("failed to unmarshal: %v", err)
+			}
+			tc.check(t, &p)
+		})
+	}
+}
@@ -36,3 +36,18 @@ type ResponsePayload struct {
 	// 원본 메시지의 교체 여부를 지정합니다.
 	ReplaceOriginal bool `json:"replace_original"`
 }
+
+type ResponseAction string
+
+const (
+	// 모달을 닫지 않고 입력 블록에 오류 메시지를 표시한다.
+	ResponseActionErrors ResponseAction = "errors"
+)
+
+// 모달 제출(view_submission)에 대한 확인 응답으로 전달하는 데이터.
+type ViewSubmissionResponse struct {
+	// 모달을 어떻게 처리할지 지정합니다.
+	ResponseAction ResponseAction `json:"response_action"`
+	// 입력 블록 ID별 오류 메시지. ResponseActionErrors와 함께 사용합니다.
+	Errors map[string]string `json:"errors,omitempty"`
+}
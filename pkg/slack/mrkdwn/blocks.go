@@ -0,0 +1,182 @@
+package mrkdwn
+
+import (
+	"strings"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+)
+
+const (
+	// 섹션 블록 텍스트의 최대 길이.
+	maxSectionLength = 3000
+	// 헤더 블록 텍스트의 최대 길이.
+	maxHeaderLength = 150
+	// 메시지 대체 텍스트의 최대 길이. 넘치는 부분은 잘라냅니다.
+	maxFallbackLength = 4000
+)
+
+// 긴 텍스트를 나눌 때 우선하는 경계. 문단, 줄, 문장, 단어 순서로 시도합니다.
+var textBoundaries = []string{"\n\n", "\n", ". ", " "}
+
+// Message는 하나의 Slack 메시지로 게시할 변환 결과입니다.
+type Message struct {
+	// 알림과 블록을 표시할 수 없는 클라이언트에서 사용하는 대체 텍스트.
+	Text string
+	// 메시지 본문 블록.
+	Blocks []*blockkit.Block
+}
+
+// Blocks는 Markdown 텍스트를 Block Kit 블록으로 변환합니다.
+//
+// 제목은 헤더 블록으로, 구분선은 구분선 블록으로, 나머지는 mrkdwn 섹션 블록으로 변환합니다.
+// 섹션 블록의 길이 제한을 넘는 텍스트는 문단, 줄, 문장, 단어 경계 순서로 나누며
+// 코드 블록은 줄 단위로 나누어 각 블록을 다시 코드 블록으로 감쌉니다.
+func Blocks(markdown string) []*blockkit.Block {
+	var (
+		blocks  []*blockkit.Block
+		current string
+	)
+	flush := func() {
+		if current == "" {
+			return
+		}
+		blocks = append(blocks, blockkit.NewBlockWithSectionBlock(&blockkit.SectionBlock{
+			Text:   blockkit.NewMarkdownText(current, false),
+			Expand: true,
+		}))
+		current = ""
+	}
+	add := func(text string) {
+		if current != "" && runeCount(current)+1+runeCount(text) <= maxSectionLength {
+			current += "\n" + text
+			return
+		}
+		flush()
+		current = text
+	}
+
+	for _, s := range parse(markdown) {
+		switch s.kind {
+		case segmentHeading:
+			if s.text == "" {
+				continue
+			}
+			if runeCount(s.text) > maxHeaderLength {
+				for _, chunk := range splitText("*"+escape(s.text)+"*", maxSectionLength, textBoundaries) {
+					add(chunk)
+				}
+				continue
+			}
+			flush()
+			blocks = append(blocks, blockkit.NewBlockWithHeaderBlock(&blockkit.HeaderBlock{
+				Text: blockkit.NewPlainText(s.text, true),
+			}))
+		case segmentRule:
+			flush()
+			blocks = append(blocks, blockkit.NewBlockWithDividerBlock())
+		case segmentCode:
+			for _, chunk := range splitText(s.text, maxSectionLength-runeCount(fence("")), []string{"\n"}) {
+				add(fence(chunk))
+			}
+		default:
+			for _, chunk := range splitText(s.text, maxSectionLength, textBoundaries) {
+				add(chunk)
+			}
+		}
+	}
+	flush()
+
+	return blocks
+}
+
+// Split은 Markdown 텍스트를 블록으로 변환한 뒤 메시지마다 최대 maxBlocks개의 블록이 담기도록 나눕니다.
+// 메시지가 헤더 블록으로 끝나지 않도록 헤더 블록은 뒤따르는 본문과 같은 메시지에 담습니다.
+//
+// 변환된 블록이 없으면 대체 텍스트만 담은 메시지 하나를 반환합니다.
+func Split(markdown string, maxBlocks int) []Message {
+	blocks := Blocks(markdown)
+	if len(blocks) == 0 {
+		return []Message{{Text: truncate(Convert(markdown), maxFallbackLength)}}
+	}
+	maxBlocks = max(maxBlocks, 1)
+
+	var messages []Message
+	for start := 0; start < len(blocks); {
+		end := min(start+maxBlocks, len(blocks))
+		if end < len(blocks) && end-1 > start && blocks[end-1].Type == blockkit.BlockTypeHeader {
+			end--
+		}
+		// 호출자가 메시지에 블록을 덧붙여도 다음 메시지의 블록을 덮어쓰지 않도록 용량을 제한한다.
+		messages = append(messages, Message{
+			Text:   fallbackText(blocks[start:end]),
+			Blocks: blocks[start:end:end],
+		})
+		start = end
+	}
+	return messages
+}
+
+// fallbackText는 헤더 블록과 섹션 블록의 텍스트를 이어 붙여 메시지 대체 텍스트를 만듭니다.
+func fallbackText(blocks []*blockkit.Block) string {
+	var parts []string
+	for _, b := range blocks {
+		switch {
+		case b.OfHeaderBlock != nil:
+			parts = append(parts, "*"+escape(b.OfHeaderBlock.Text.Text)+"*")
+		case b.OfSectionBlock != nil && b.OfSectionBlock.Text != nil:
+			parts = append(parts, b.OfSectionBlock.Text.Text)
+		}
+	}
+	return truncate(strings.Join(parts, "\n"), maxFallbackLength)
+}
+
+// splitText는 텍스트가 limit 이하의 길이가 되도록 boundaries의 경계를 앞에서부터 차례로 사용하여 나눕니다.
+// 어떤 경계로도 나눌 수 없는 부분은 문자 단위로 자릅니다.
+func splitText(text string, limit int, boundaries []string) []string {
+	if runeCount(text) <= limit {
+		return []string{text}
+	}
+	if len(boundaries) == 0 {
+		var chunks []string
+		r := []rune(text)
+		for len(r) > limit {
+			chunks = append(chunks, string(r[:limit]))
+			r = r[limit:]
+		}
+		return append(chunks, string(r))
+	}
+
+	var (
+		chunks  []string
+		current string
+	)
+	flush := func() {
+		if chunk := strings.TrimRight(current, " \n"); strings.TrimSpace(chunk) != "" {
+			chunks = append(chunks, strings.TrimLeft(chunk, "\n"))
+		}
+		current = ""
+	}
+	for _, part := range strings.SplitAfter(text, boundaries[0]) {
+		switch {
+		case runeCount(part) > limit:
+			flush()
+			chunks = append(chunks, splitText(part, limit, boundaries[1:])...)
+		case runeCount(current)+runeCount(part) > limit:
+			flush()
+			current = part
+		default:
+			current += part
+		}
+	}
+	flush()
+
+	return chunks
+}
+
+// truncate는 텍스트가 limit보다 길면 잘라내고 말줄임표를 붙입니다.
+func truncate(text string, limit int) string {
+	if runeCount(text) <= limit {
+		return text
+	}
+	return string([]rune(text)[:limit-1]) + "…"
+}
@@ -0,0 +1,150 @@
+package mrkdwn_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/blockkit"
+	"github.com/joyfuldevs/project-lumos/pkg/slack/mrkdwn"
+)
+
+// summary는 블록을 비교하기 쉽도록 "type:text" 형식으로 요약합니다.
+func summary(blocks []*blockkit.Block) []string {
+	s := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		switch {
+		case b.OfHeaderBlock != nil:
+			s = append(s, "header:"+b.OfHeaderBlock.Text.Text)
+		case b.OfSectionBlock != nil:
+			s = append(s, "section:"+b.OfSectionBlock.Text.Text)
+		default:
+			s = append(s, string(b.Type))
+		}
+	}
+	return s
+}
+
+func TestBlocks(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		markdown string
+		want     []string
+	}{
+		{
+			desc:     "heading and rule",
+			markdown: "# **결제** 장애\n원인은 *타임아웃*입니다.\n\n---\n## 참고\n- PAY-1",
+			want: []string{
+				"header:결제 장애",
+				"section:원인은 _타임아웃_입니다.",
+				"divider",
+				"header:참고",
+				"section:• PAY-1",
+			},
+		},
+		{
+			desc:     "code joins surrounding text",
+			markdown: "예시:\n```\nx := 1\n```\n끝",
+			want:     []string{"section:예시:\n```\nx := 1\n```\n끝"},
+		},
+		{
+			desc:     "long heading",
+			markdown: "# " + strings.Repeat("가", 151),
+			want:     []string{"section:*" + strings.Repeat("가", 151) + "*"},
+		},
+		{
+			desc:     "long paragraphs split on paragraph boundary",
+			markdown: strings.Repeat("가", 2000) + "\n\n" + strings.Repeat("나", 2000),
+			want: []string{
+				"section:" + strings.Repeat("가", 2000),
+				"section:" + strings.Repeat("나", 2000),
+			},
+		},
+		{
+			desc:     "long line split on sentence boundary",
+			markdown: strings.Repeat("가", 1999) + ". " + strings.Repeat("나", 1999) + ".",
+			want: []string{
+				"section:" + strings.Repeat("가", 1999) + ".",
+				"section:" + strings.Repeat("나", 1999) + ".",
+			},
+		},
+		{
+			desc:     "long code block reopens fence",
+			markdown: "```\n" + strings.Repeat(strings.Repeat("a", 999)+"\n", 4) + "```",
+			want: []string{
+				"section:```\n" + strings.TrimSuffix(strings.Repeat(strings.Repeat("a", 999)+"\n", 2), "\n") + "\n```",
+				"section:```\n" + strings.TrimSuffix(strings.Repeat(strings.Repeat("a", 999)+"\n", 2), "\n") + "\n```",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			blocks := mrkdwn.Blocks(tc.markdown)
+			got := summary(blocks)
+			if len(got) != len(tc.want) {
+				t.Fatalf("Blocks() = %q, want %q", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("block %d = %q, want %q", i, got[i], tc.want[i])
+				}
+			}
+			if err := blockkit.ValidateBlocks(blocks); err != nil {
+				t.Errorf("ValidateBlocks() = %v", err)
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		markdown  string
+		maxBlocks int
+		want      [][]string
+	}{
+		{
+			desc:      "single message",
+			markdown:  "# 제목\n본문",
+			maxBlocks: 50,
+			want:      [][]string{{"header:제목", "section:본문"}},
+		},
+		{
+			desc:      "header moves to next message",
+			markdown:  "---\n# 제목\n본문",
+			maxBlocks: 2,
+			want:      [][]string{{"divider"}, {"header:제목", "section:본문"}},
+		},
+		{
+			desc:      "no blocks",
+			markdown:  "",
+			maxBlocks: 50,
+			want:      [][]string{{}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			messages := mrkdwn.Split(tc.markdown, tc.maxBlocks)
+			if len(messages) != len(tc.want) {
+				t.Fatalf("Split() returned %d messages, want %d", len(messages), len(tc.want))
+			}
+			for i, m := range messages {
+				got := summary(m.Blocks)
+				if strings.Join(got, "\x00") != strings.Join(tc.want[i], "\x00") {
+					t.Errorf("message %d blocks = %q, want %q", i, got, tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSplitFallbackText(t *testing.T) {
+	messages := mrkdwn.Split("# 제목\n**본문** <b>", 50)
+	if len(messages) != 1 {
+		t.Fatalf("Split() returned %d messages, want 1", len(messages))
+	}
+	if want := "*제목*\n*본문* &lt;b&gt;"; messages[0].Text != want {
+		t.Errorf("Text = %q, want %q", messages[0].Text, want)
+	}
+}
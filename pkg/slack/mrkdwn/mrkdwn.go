@@ -0,0 +1,238 @@
+// Package mrkdwn은 Markdown 텍스트를 Slack mrkdwn 형식과 Block Kit 블록으로 변환합니다.
+package mrkdwn
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	fencePattern     = regexp.MustCompile("^\\s{0,3}(```+|~~~+)")
+	headingPattern   = regexp.MustCompile(`^\s{0,3}(#{1,6})\s+(.*?)(?:\s+#+)?\s*$`)
+	rulePattern      = regexp.MustCompile(`^\s{0,3}(?:(?:\*\s*){3,}|(?:-\s*){3,}|(?:_\s*){3,})$`)
+	bulletPattern    = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	taskPattern      = regexp.MustCompile(`^\[([ xX])\]\s+(.*)$`)
+	orderedPattern   = regexp.MustCompile(`^(\s*)(\d+)[.)]\s+(.*)$`)
+	quotePattern     = regexp.MustCompile(`^\s{0,3}>\s?(.*)$`)
+	codeSpanPattern  = regexp.MustCompile("`+[^`]*`+")
+	linkPattern      = regexp.MustCompile(`!?\[([^\]]*)\]\(\s*([^)\s]+)(?:\s+"[^"]*")?\s*\)|<((?:https?|mailto):[^>\s]+)>`)
+	boldPattern      = regexp.MustCompile(`\*\*(\S(?:.*?\S)?)\*\*`)
+	italicPattern    = regexp.MustCompile(`(^|[^*\w])\*(\S(?:[^*]*?\S)?)\*`)
+	strikePattern    = regexp.MustCompile(`~~(\S(?:.*?\S)?)~~`)
+	emphasisReplacer = strings.NewReplacer("**", "", "*", "", "~~", "", "`", "")
+	tokenPattern     = regexp.MustCompile(tokenStart + `\d+` + tokenEnd)
+	markerReplacer   = strings.NewReplacer(boldMarker, "", tokenStart, "", tokenEnd, "")
+	escapeReplacer   = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+)
+
+const (
+	// 변환 중 굵은 글씨 표시를 기울임 변환과 구분하기 위해 잠시 사용하는 문자.
+	boldMarker = "\x00"
+	// 변환 중 코드 스팬과 링크 자리에 넣는 자리 표시자의 시작과 끝 문자.
+	tokenStart = "\x01"
+	tokenEnd   = "\x02"
+)
+
+// Convert는 Markdown 텍스트를 Slack mrkdwn 텍스트로 변환합니다.
+//
+// 제목은 굵은 글씨로, 표는 열을 맞춘 코드 블록으로, 구분선은 가로선 문자로 표시합니다.
+// 블록으로 나누어 표시하려면 Blocks나 Split을 사용합니다.
+func Convert(markdown string) string {
+	var parts []string
+	for _, s := range parse(markdown) {
+		switch s.kind {
+		case segmentHeading:
+			parts = append(parts, "*"+escape(s.text)+"*")
+		case segmentRule:
+			parts = append(parts, "──────────")
+		default:
+			parts = append(parts, s.mrkdwn())
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+type segmentKind int
+
+const (
+	// mrkdwn으로 변환한 문단, 목록, 인용문.
+	segmentText segmentKind = iota
+	// 코드 블록 또는 코드 블록으로 변환한 표. text는 펜스를 제외한 내용입니다.
+	segmentCode
+	// 제목. text는 서식을 제거한 일반 텍스트입니다.
+	segmentHeading
+	// 구분선.
+	segmentRule
+)
+
+// segment는 Markdown 문서를 블록으로 나눌 때 기준이 되는 단위입니다.
+type segment struct {
+	kind segmentKind
+	text string
+}
+
+// mrkdwn은 세그먼트를 Slack mrkdwn 텍스트로 표시합니다.
+func (s segment) mrkdwn() string {
+	if s.kind == segmentCode {
+		return fence(s.text)
+	}
+	return s.text
+}
+
+// parse는 Markdown 문서를 줄 단위로 읽어 세그먼트 목록으로 나눕니다.
+// 연속된 문단은 빈 줄을 유지한 채로 하나의 세그먼트로 합칩니다.
+func parse(markdown string) []segment {
+	// 변환에 사용하는 제어 문자가 입력에 섞여 있으면 지운다.
+	markdown = markerReplacer.Replace(strings.ReplaceAll(markdown, "\r\n", "\n"))
+	lines := strings.Split(markdown, "\n")
+
+	var (
+		segments []segment
+		text     []string
+	)
+	flush := func() {
+		joined := strings.Trim(strings.Join(text, "\n"), "\n")
+		if strings.TrimSpace(joined) != "" {
+			segments = append(segments, segment{kind: segmentText, text: joined})
+		}
+		text = text[:0]
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if m := fencePattern.FindStringSubmatch(line); m != nil {
+			flush()
+			var code []string
+			for i++; i < len(lines); i++ {
+				if strings.HasPrefix(strings.TrimSpace(lines[i]), m[1]) {
+					break
+				}
+				code = append(code, lines[i])
+			}
+			segments = append(segments, segment{kind: segmentCode, text: escape(strings.Join(code, "\n"))})
+			continue
+		}
+
+		if m := headingPattern.FindStringSubmatch(line); m != nil {
+			flush()
+			segments = append(segments, segment{kind: segmentHeading, text: plain(m[2])})
+			continue
+		}
+
+		if rulePattern.MatchString(line) {
+			flush()
+			segments = append(segments, segment{kind: segmentRule})
+			continue
+		}
+
+		if i+1 < len(lines) && isTableRow(line) && isTableDelimiter(lines[i+1]) {
+			flush()
+			rows := [][]string{tableCells(line)}
+			for i += 2; i < len(lines) && isTableRow(lines[i]); i++ {
+				rows = append(rows, tableCells(lines[i]))
+			}
+			i--
+			segments = append(segments, segment{kind: segmentCode, text: escape(renderTable(rows))})
+			continue
+		}
+
+		text = append(text, convertLine(line))
+	}
+	flush()
+
+	return segments
+}
+
+// convertLine은 문단, 목록, 인용문의 한 줄을 mrkdwn으로 변환합니다.
+func convertLine(line string) string {
+	if m := quotePattern.FindStringSubmatch(line); m != nil {
+		// mrkdwn은 중첩된 인용문을 지원하지 않으므로 한 단계로 합친다.
+		return "> " + strings.TrimPrefix(convertLine(m[1]), "> ")
+	}
+	if m := bulletPattern.FindStringSubmatch(line); m != nil {
+		marker, item := "•", m[2]
+		if t := taskPattern.FindStringSubmatch(item); t != nil {
+			marker, item = "☐", t[2]
+			if t[1] != " " {
+				marker = "☑"
+			}
+		}
+		return m[1] + marker + " " + inline(item)
+	}
+	if m := orderedPattern.FindStringSubmatch(line); m != nil {
+		return m[1] + m[2] + ". " + inline(m[3])
+	}
+	return inline(strings.TrimRight(line, " \t"))
+}
+
+// inline은 한 줄의 인라인 서식을 mrkdwn으로 변환합니다.
+//
+// 코드 스팬과 링크를 자리 표시자로 바꾼 뒤 줄 전체의 문자를 이스케이프하고 강조 서식을 변환하고,
+// 마지막에 변환한 코드 스팬과 링크를 채워 넣습니다. 따라서 링크나 코드 스팬을 감싼 강조 서식도 변환됩니다.
+func inline(text string) string {
+	var tokens []string
+	placeholder := func(s string) string {
+		tokens = append(tokens, s)
+		return tokenStart + strconv.Itoa(len(tokens)-1) + tokenEnd
+	}
+	restore := func(s string) string {
+		return tokenPattern.ReplaceAllStringFunc(s, func(t string) string {
+			i, _ := strconv.Atoi(t[1 : len(t)-1])
+			return tokens[i]
+		})
+	}
+
+	text = codeSpanPattern.ReplaceAllStringFunc(text, func(s string) string {
+		span := strings.TrimSpace(strings.Trim(s, "`"))
+		if span == "" {
+			return ""
+		}
+		return placeholder("`" + escape(span) + "`")
+	})
+	text = linkPattern.ReplaceAllStringFunc(text, func(s string) string {
+		m := linkPattern.FindStringSubmatch(s)
+		if m[3] != "" {
+			return placeholder("<" + m[3] + ">")
+		}
+		// 링크 텍스트 안의 코드 스팬은 서식을 지우고 텍스트만 남긴다.
+		url, label := m[2], plain(restore(m[1]))
+		if label == "" || label == url {
+			return placeholder("<" + url + ">")
+		}
+		return placeholder("<" + url + "|" + escape(label) + ">")
+	})
+
+	return restore(emphasis(escape(text)))
+}
+
+// emphasis는 Markdown의 굵은 글씨, 기울임, 취소선 서식을 mrkdwn 서식으로 변환합니다.
+func emphasis(text string) string {
+	text = boldPattern.ReplaceAllString(text, boldMarker+"$1"+boldMarker)
+	text = italicPattern.ReplaceAllString(text, "${1}_${2}_")
+	text = strikePattern.ReplaceAllString(text, "~$1~")
+	return strings.ReplaceAll(text, boldMarker, "*")
+}
+
+// plain은 서식 기호를 제거하고 링크는 링크 텍스트만 남깁니다.
+func plain(text string) string {
+	text = linkPattern.ReplaceAllStringFunc(text, func(s string) string {
+		m := linkPattern.FindStringSubmatch(s)
+		if m[3] != "" {
+			return m[3]
+		}
+		return m[1]
+	})
+	return strings.TrimSpace(emphasisReplacer.Replace(text))
+}
+
+// escape는 Slack이 제어 문자로 사용하는 &, <, >를 이스케이프합니다.
+func escape(text string) string {
+	return escapeReplacer.Replace(text)
+}
+
+// fence는 텍스트를 mrkdwn 코드 블록으로 감쌉니다.
+func fence(code string) string {
+	return "```\n" + code + "\n```"
+}
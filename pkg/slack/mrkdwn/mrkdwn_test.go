@@ -0,0 +1,104 @@
+package mrkdwn_test
+
+import (
+	"testing"
+
+	"github.com/joyfuldevs/project-lumos/pkg/slack/mrkdwn"
+)
+
+func TestConvert(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		markdown string
+		want     string
+	}{
+		{
+			desc:     "emphasis",
+			markdown: "**굵게** *기울임* _기울임_ ~~취소~~",
+			want:     "*굵게* _기울임_ _기울임_ ~취소~",
+		},
+		{
+			desc:     "bold and italic in one line",
+			markdown: "**PAY-1234** 이슈는 *해결됨* 상태입니다.",
+			want:     "*PAY-1234* 이슈는 _해결됨_ 상태입니다.",
+		},
+		{
+			desc:     "links",
+			markdown: "[PAY-1234](https://jira.example.com/browse/PAY-1234) 참고, <https://example.com>",
+			want:     "<https://jira.example.com/browse/PAY-1234|PAY-1234> 참고, <https://example.com>",
+		},
+		{
+			desc:     "bold link",
+			markdown: "**[PAY-1234](https://jira.example.com/browse/PAY-1234)** 참고",
+			want:     "*<https://jira.example.com/browse/PAY-1234|PAY-1234>* 참고",
+		},
+		{
+			desc:     "bold code span",
+			markdown: "**`config.yaml`** 파일",
+			want:     "*`config.yaml`* 파일",
+		},
+		{
+			desc:     "code span in link text",
+			markdown: "[`config.yaml`](https://example.com/config.yaml)",
+			want:     "<https://example.com/config.yaml|config.yaml>",
+		},
+		{
+			desc:     "underscores are not bold",
+			markdown: "__init__ 메서드와 snake_case_name",
+			want:     "__init__ 메서드와 snake_case_name",
+		},
+		{
+			desc:     "escape",
+			markdown: "a < b && c > d",
+			want:     "a &lt; b &amp;&amp; c &gt; d",
+		},
+		{
+			desc:     "code span keeps markdown",
+			markdown: "`**raw** <tag>` 그리고 **굵게**",
+			want:     "`**raw** &lt;tag&gt;` 그리고 *굵게*",
+		},
+		{
+			desc:     "heading",
+			markdown: "## 요약 ##\n본문",
+			want:     "*요약*\n본문",
+		},
+		{
+			desc:     "lists",
+			markdown: "- 첫째\n  * 둘째\n1. 하나\n2) 둘\n- [ ] 할 일\n- [x] 완료",
+			want:     "• 첫째\n  • 둘째\n1. 하나\n2. 둘\n☐ 할 일\n☑ 완료",
+		},
+		{
+			desc:     "quote",
+			markdown: "> 인용 **강조**\n>> 중첩",
+			want:     "> 인용 *강조*\n> 중첩",
+		},
+		{
+			desc:     "code block",
+			markdown: "설명\n```go\nif a < b && **x** {\n}\n```\n끝",
+			want:     "설명\n```\nif a &lt; b &amp;&amp; **x** {\n}\n```\n끝",
+		},
+		{
+			desc:     "table",
+			markdown: "| 키 | 상태 |\n|---|:---:|\n| PAY-1 | **Done** |\n| AUTH-12 | In Progress |",
+			want: "```\n" +
+				"키      | 상태\n" +
+				"--------+------------\n" +
+				"PAY-1   | Done\n" +
+				"AUTH-12 | In Progress\n" +
+				"```",
+		},
+		{
+			desc:     "rule",
+			markdown: "위\n\n---\n\n아래",
+			want:     "위\n──────────\n아래",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := mrkdwn.Convert(tc.markdown); got != tc.want {
+				t.Errorf("Convert() =\n%s\nwant\n%s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package mrkdwn
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var tableDelimiterPattern = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+
+func isTableRow(line string) bool {
+	return strings.Contains(line, "|") && strings.TrimSpace(line) != ""
+}
+
+func isTableDelimiter(line string) bool {
+	return strings.Contains(line, "-") && tableDelimiterPattern.MatchString(line)
+}
+
+// tableCells는 표의 한 행을 셀 목록으로 나누고 셀의 서식을 제거합니다.
+func tableCells(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	line = strings.TrimSuffix(line, "|")
+
+	// 이스케이프된 |는 셀 구분자가 아니다.
+	cells := strings.Split(strings.ReplaceAll(line, `\|`, "\x00"), "|")
+	for i, cell := range cells {
+		cells[i] = plain(strings.ReplaceAll(cell, "\x00", "|"))
+	}
+	return cells
+}
+
+// renderTable은 Slack이 표를 지원하지 않으므로 고정폭 글꼴에서 열이 맞도록 표를 텍스트로 그립니다.
+// 첫 번째 행은 머리글로 보고 아래에 구분선을 긋습니다.
+func renderTable(rows [][]string) string {
+	var widths []int
+	for _, row := range rows {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], displayWidth(cell))
+		}
+	}
+
+	lines := make([]string, 0, len(rows)+1)
+	for r, row := range rows {
+		cells := make([]string, len(widths))
+		for i := range widths {
+			var cell string
+			if i < len(row) {
+				cell = row[i]
+			}
+			cells[i] = cell + strings.Repeat(" ", widths[i]-displayWidth(cell))
+		}
+		lines = append(lines, strings.TrimRight(strings.Join(cells, " | "), " "))
+
+		if r == 0 {
+			rules := make([]string, len(widths))
+			for i, w := range widths {
+				rules[i] = strings.Repeat("-", w)
+			}
+			lines = append(lines, strings.Join(rules, "-+-"))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// displayWidth는 고정폭 글꼴에서 텍스트가 차지하는 칸 수를 계산합니다.
+// 한글, 한자 등 전각 문자는 두 칸으로 셉니다.
+func displayWidth(text string) int {
+	width := 0
+	for _, r := range text {
+		if isWide(r) {
+			width += 2
+		} else {
+			width++
+		}
+	}
+	return width
+}
+
+func isWide(r rune) bool {
+	switch {
+	case r < 0x1100:
+		return false
+	case r <= 0x115F, // 한글 자모
+		0x2E80 <= r && r <= 0x303E, // CJK 부수, 기호
+		0x3041 <= r && r <= 0x33FF, // 가나, 호환 자모
+		0x3400 <= r && r <= 0x4DBF, // CJK 통합 한자 확장 A
+		0x4E00 <= r && r <= 0x9FFF, // CJK 통합 한자
+		0xAC00 <= r && r <= 0xD7A3, // 한글 음절
+		0xF900 <= r && r <= 0xFAFF, // CJK 호환 한자
+		0xFF00 <= r && r <= 0xFF60, // 전각 문자
+		0xFFE0 <= r && r <= 0xFFE6:
+		return true
+	}
+	return false
+}
+
+// runeCount는 Slack이 길이 제한에 사용하는 문자 수를 셉니다.
+func runeCount(text string) int {
+	return utf8.RuneCountInString(text)
+}
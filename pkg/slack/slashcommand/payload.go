@@ -0,0 +1,31 @@
+package slashcommand
+
+// Payload는 사용자가 슬래시 커맨드를 실행했을 때 전달되는 데이터입니다.
+type Payload struct {
+	// 커맨드 이름. e.g., "/lumos"
+	Command string `json:"command"`
+	// 커맨드 뒤에 입력한 내용. e.g., "/lumos 결제 실패 원인" 의 "결제 실패 원인"
+	Text string `json:"text"`
+	// 커맨드를 실행한 채널 ID.
+	ChannelID string `json:"channel_id"`
+	// 커맨드를 실행한 채널 이름.
+	ChannelName string `json:"channel_name"`
+	// 커맨드를 실행한 사용자 ID.
+	UserID string `json:"user_id"`
+	// 커맨드를 실행한 사용자 이름.
+	UserName string `json:"user_name"`
+	// 워크스페이스 ID.
+	TeamID string `json:"team_id"`
+	// 워크스페이스 도메인.
+	TeamDomain string `json:"team_domain"`
+	// Enterprise Grid 조직 ID.
+	EnterpriseID string `json:"enterprise_id,omitempty"`
+	// 앱 ID.
+	APIAppID string `json:"api_app_id"`
+	// 조직 단위로 설치된 앱인지 여부.
+	IsEnterpriseInstall bool `json:"is_enterprise_install,string"`
+	// 커맨드에 응답할 수 있는 임시 URL. 30분 동안 최대 5번 사용할 수 있습니다.
+	ResponseURL string `json:"response_url"`
+	// 모달을 열 때 사용하는 임시 ID.
+	TriggerID string `json:"trigger_id"`
+}
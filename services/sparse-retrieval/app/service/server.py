@@ -1,6 +1,7 @@
 """
 gRPC Server Implementation
 """
+import json
 import logging
 import sys
 import os
@@ -65,8 +66,15 @@ class PassageRetrievalServicer(service_pb2_grpc.PassageRetrievalServiceServicer)
             for passage in passages:
                 proto_passage = response.passages.add()
                 proto_passage.score = passage["score"]
-                # content를 byte로 인코딩
-                proto_passage.content = passage["content"].encode('utf-8')
+                # Dense 검색 결과와 같은 JSON 형식으로 이슈 키와 제목을 함께 전달
+                metadata = passage["metadata"]
+                content = {
+                    "Score": passage["score"],
+                    "Key": metadata.get("key", ""),
+                    "Title": metadata.get("title", ""),
+                    "Content": passage["content"],
+                }
+                proto_passage.content = json.dumps(content, ensure_ascii=False).encode('utf-8')
             
             logger.info(f"{len(response.passages)}개의 passage 반환")
             return response